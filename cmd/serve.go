@@ -3,9 +3,12 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/geropl/linear-mcp-go/pkg/linear"
 	"github.com/geropl/linear-mcp-go/pkg/server"
+	"github.com/geropl/linear-mcp-go/pkg/tools"
 	"github.com/spf13/cobra"
 )
 
@@ -32,12 +35,58 @@ The server provides tools for interacting with the Linear API through the MCP pr
 			}
 		}
 
+		maxRetries, _ := cmd.Flags().GetInt("max-retries")
+
+		// Check LINEAR_MAX_RETRIES environment variable if flag wasn't explicitly set
+		if !cmd.Flags().Changed("max-retries") {
+			if envMaxRetries := os.Getenv("LINEAR_MAX_RETRIES"); envMaxRetries != "" {
+				if parsed, err := strconv.Atoi(strings.TrimSpace(envMaxRetries)); err == nil && parsed >= 0 {
+					maxRetries = parsed
+				}
+				// If the env var is not a non-negative integer, ignore it and use the default
+			}
+		}
+
+		contentPrefix, _ := cmd.Flags().GetString("content-prefix")
+		multiTenant, _ := cmd.Flags().GetBool("multi-tenant")
+
+		// Configure how verbose issue identifiers are rendered in tool output
+		if verbosity, _ := cmd.Flags().GetString("identifier-verbosity"); verbosity != "" {
+			if err := tools.SetIdentifierVerbosity(verbosity); err != nil {
+				fmt.Printf("%v\n", err)
+				os.Exit(1)
+			}
+		}
+
+		// Load on-create triage rules if configured
+		if onCreate, _ := cmd.Flags().GetString("on-create"); onCreate != "" {
+			rules, err := tools.LoadOnCreateRules(onCreate)
+			if err != nil {
+				fmt.Printf("Failed to load on-create rules: %v\n", err)
+				os.Exit(1)
+			}
+			tools.SetOnCreateRules(rules)
+		}
+
 		// Create the Linear MCP server
-		linearServer, err := server.NewLinearMCPServer(writeAccess)
+		var linearServer *server.LinearMCPServer
+		var err error
+		if multiTenant {
+			linearServer, err = server.NewMultiTenantLinearMCPServer(writeAccess)
+		} else {
+			linearServer, err = server.NewLinearMCPServer(writeAccess)
+		}
 		if err != nil {
 			fmt.Printf("Failed to create Linear MCP server: %v\n", err)
 			os.Exit(1)
 		}
+		if multiTenant {
+			linearServer.GetClientRegistry().SetMaxRetries(maxRetries)
+			linearServer.GetClientRegistry().SetContentPrefix(contentPrefix)
+		} else {
+			linearServer.GetLinearClient().SetMaxRetries(maxRetries)
+			linearServer.GetLinearClient().SetContentPrefix(contentPrefix)
+		}
 
 		// Start the server
 		if err := linearServer.Start(); err != nil {
@@ -52,4 +101,9 @@ func init() {
 
 	// Add flags to the serve command
 	serveCmd.Flags().Bool("write-access", false, "Enable tools that modify Linear data (create/update issues, add comments)")
+	serveCmd.Flags().Int("max-retries", linear.DefaultMaxRetries, "Number of times a failed Linear API request is retried (0 disables retries)")
+	serveCmd.Flags().String("content-prefix", "", "Prefix prepended to comment bodies and issue descriptions created through this server (e.g., '🤖 via linear-mcp')")
+	serveCmd.Flags().Bool("multi-tenant", false, "Read the Linear API key from each request's 'linearApiKey' metadata field instead of the environment")
+	serveCmd.Flags().String("on-create", "", "Path to a JSON rules file with triage defaults (team → assignee/labels) applied to newly created issues")
+	serveCmd.Flags().String("identifier-verbosity", "", "Issue identifier verbosity in tool output: 'short' (just TEAM-123), 'normal' (with UUID, the default) or 'rich' (with the title inline)")
 }
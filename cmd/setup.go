@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/geropl/linear-mcp-go/pkg/linear"
 	"github.com/geropl/linear-mcp-go/pkg/server"
 	"github.com/spf13/cobra"
 )
@@ -45,6 +46,24 @@ Currently supported tools: cline, roo-code, claude-code, ona`,
 			}
 		}
 
+		// Verify the API key against the Linear API before writing any config
+		if verify, _ := cmd.Flags().GetBool("verify"); verify && apiKey != "" {
+			linearClient, err := linear.NewLinearClient(apiKey, server.ServerVersion)
+			if err != nil {
+				fmt.Printf("Error verifying Linear API key: %v\n", err)
+				os.Exit(1)
+			}
+
+			viewer, _, _, err := linearClient.GetViewer()
+			if err != nil {
+				fmt.Printf("Error: the Linear API rejected the provided LINEAR_API_KEY: %v\n", err)
+				fmt.Println("Please check the key and try again.")
+				os.Exit(1)
+			}
+
+			fmt.Printf("Verified Linear API key: authenticated as %s\n", viewer.Name)
+		}
+
 		// Create the MCP servers directory if it doesn't exist
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
@@ -119,6 +138,7 @@ func init() {
 	setupCmd.Flags().Bool("write-access", false, "Enable write operations (default: false)")
 	setupCmd.Flags().String("auto-approve", "", "Comma-separated list of tool names to auto-approve, or 'allow-read-only' to auto-approve all read-only tools")
 	setupCmd.Flags().String("project-path", "", "The project path(s) for claude-code project-scoped configuration (comma-separated for multiple projects, or empty to register to user scope for all projects)")
+	setupCmd.Flags().Bool("verify", false, "Verify the LINEAR_API_KEY against the Linear API before writing any config")
 }
 
 // checkBinary checks if the Linear MCP binary is already on the path
@@ -406,6 +426,21 @@ func setupTool(toolName string, binaryPath, apiKey string, writeAccess bool, aut
 	return nil
 }
 
+// vscodeSettingsDir returns the VS Code global-storage settings directory of an
+// extension for the given OS, rooted at homeDir.
+func vscodeSettingsDir(goos, homeDir, extensionID string) (string, error) {
+	switch goos {
+	case "darwin":
+		return filepath.Join(homeDir, "Library", "Application Support", "Code", "User", "globalStorage", extensionID, "settings"), nil
+	case "linux":
+		return filepath.Join(homeDir, ".vscode-server", "data", "User", "globalStorage", extensionID, "settings"), nil
+	case "windows":
+		return filepath.Join(homeDir, "AppData", "Roaming", "Code", "User", "globalStorage", extensionID, "settings"), nil
+	default:
+		return "", fmt.Errorf("unsupported OS: %s", goos)
+	}
+}
+
 // setupCline sets up the Linear MCP server for Cline
 func setupCline(binaryPath, apiKey string, writeAccess bool, autoApprove string) error {
 	// Determine the Cline config directory
@@ -414,16 +449,9 @@ func setupCline(binaryPath, apiKey string, writeAccess bool, autoApprove string)
 		return fmt.Errorf("failed to get user home directory: %w", err)
 	}
 
-	var configDir string
-	switch runtime.GOOS {
-	case "darwin":
-		configDir = filepath.Join(homeDir, "Library", "Application Support", "Code", "User", "globalStorage", "saoudrizwan.claude-dev", "settings")
-	case "linux":
-		configDir = filepath.Join(homeDir, ".vscode-server", "data", "User", "globalStorage", "saoudrizwan.claude-dev", "settings")
-	case "windows":
-		configDir = filepath.Join(homeDir, "AppData", "Roaming", "Code", "User", "globalStorage", "saoudrizwan.claude-dev", "settings")
-	default:
-		return fmt.Errorf("unsupported OS: %s", runtime.GOOS)
+	configDir, err := vscodeSettingsDir(runtime.GOOS, homeDir, "saoudrizwan.claude-dev")
+	if err != nil {
+		return err
 	}
 
 	return setupTool("Cline", binaryPath, apiKey, writeAccess, autoApprove, configDir)
@@ -437,16 +465,9 @@ func setupRooCode(binaryPath, apiKey string, writeAccess bool, autoApprove strin
 		return fmt.Errorf("failed to get user home directory: %w", err)
 	}
 
-	var configDir string
-	switch runtime.GOOS {
-	case "darwin":
-		configDir = filepath.Join(homeDir, "Library", "Application Support", "Code", "User", "globalStorage", "rooveterinaryinc.roo-cline", "settings")
-	case "linux":
-		configDir = filepath.Join(homeDir, ".vscode-server", "data", "User", "globalStorage", "rooveterinaryinc.roo-cline", "settings")
-	case "windows":
-		configDir = filepath.Join(homeDir, "AppData", "Roaming", "Code", "User", "globalStorage", "rooveterinaryinc.roo-cline", "settings")
-	default:
-		return fmt.Errorf("unsupported OS: %s", runtime.GOOS)
+	configDir, err := vscodeSettingsDir(runtime.GOOS, homeDir, "rooveterinaryinc.roo-cline")
+	if err != nil {
+		return err
 	}
 
 	return setupTool("Roo Code", binaryPath, apiKey, writeAccess, autoApprove, configDir)
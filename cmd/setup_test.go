@@ -70,7 +70,7 @@ func TestSetupCommand(t *testing.T) {
 									"env": {
 										"LINEAR_API_KEY": "test-api-key"
 									},
-									"autoApprove": ["linear_get_initiative", "linear_get_issue", "linear_get_issue_comments", "linear_get_milestone", "linear_get_project", "linear_get_teams", "linear_get_user_issues", "linear_search_issues", "linear_search_projects"],
+									"autoApprove": ["linear_api_metrics", "linear_board", "linear_comment_count", "linear_compare_issues", "linear_completed_since", "linear_cycle_new_issues", "linear_get_comment_thread", "linear_get_drafts", "linear_get_initiative", "linear_get_initiative_updates", "linear_get_issue", "linear_get_issue_ancestors", "linear_get_issue_comments", "linear_get_issue_document", "linear_get_labels", "linear_get_milestone", "linear_get_project", "linear_get_project_issues", "linear_get_team", "linear_get_team_status_options", "linear_get_teams", "linear_get_user_issues", "linear_get_viewer", "linear_issues_by_label", "linear_label_usage", "linear_list_cycles", "linear_list_milestones", "linear_list_projects", "linear_list_users", "linear_mentions", "linear_my_work", "linear_project_timeline", "linear_resolve_issues", "linear_resolve_url", "linear_search_issues", "linear_search_projects", "linear_stale_issues", "linear_team_workload", "linear_wait_for_change"],
 									"disabled": false
 								}
 							}
@@ -98,7 +98,7 @@ func TestSetupCommand(t *testing.T) {
 									"env": {
 										"LINEAR_API_KEY": "test-api-key"
 									},
-									"autoApprove": ["linear_get_initiative", "linear_get_issue", "linear_get_issue_comments", "linear_get_milestone", "linear_get_project", "linear_get_teams", "linear_get_user_issues", "linear_search_issues", "linear_search_projects"],
+									"autoApprove": ["linear_api_metrics", "linear_board", "linear_comment_count", "linear_compare_issues", "linear_completed_since", "linear_cycle_new_issues", "linear_get_comment_thread", "linear_get_drafts", "linear_get_initiative", "linear_get_initiative_updates", "linear_get_issue", "linear_get_issue_ancestors", "linear_get_issue_comments", "linear_get_issue_document", "linear_get_labels", "linear_get_milestone", "linear_get_project", "linear_get_project_issues", "linear_get_team", "linear_get_team_status_options", "linear_get_teams", "linear_get_user_issues", "linear_get_viewer", "linear_issues_by_label", "linear_label_usage", "linear_list_cycles", "linear_list_milestones", "linear_list_projects", "linear_list_users", "linear_mentions", "linear_my_work", "linear_project_timeline", "linear_resolve_issues", "linear_resolve_url", "linear_search_issues", "linear_search_projects", "linear_stale_issues", "linear_team_workload", "linear_wait_for_change"],
 									"disabled": false
 								}
 							}
@@ -126,7 +126,7 @@ func TestSetupCommand(t *testing.T) {
 									"env": {
 										"LINEAR_API_KEY": "test-api-key"
 									},
-									"autoApprove": ["linear_get_initiative", "linear_get_issue", "linear_get_issue_comments", "linear_get_milestone", "linear_get_project", "linear_get_teams", "linear_get_user_issues", "linear_search_issues", "linear_search_projects"],
+									"autoApprove": ["linear_api_metrics", "linear_board", "linear_comment_count", "linear_compare_issues", "linear_completed_since", "linear_cycle_new_issues", "linear_get_comment_thread", "linear_get_drafts", "linear_get_initiative", "linear_get_initiative_updates", "linear_get_issue", "linear_get_issue_ancestors", "linear_get_issue_comments", "linear_get_issue_document", "linear_get_labels", "linear_get_milestone", "linear_get_project", "linear_get_project_issues", "linear_get_team", "linear_get_team_status_options", "linear_get_teams", "linear_get_user_issues", "linear_get_viewer", "linear_issues_by_label", "linear_label_usage", "linear_list_cycles", "linear_list_milestones", "linear_list_projects", "linear_list_users", "linear_mentions", "linear_my_work", "linear_project_timeline", "linear_resolve_issues", "linear_resolve_url", "linear_search_issues", "linear_search_projects", "linear_stale_issues", "linear_team_workload", "linear_wait_for_change"],
 									"disabled": false
 								}
 							}
@@ -143,7 +143,7 @@ func TestSetupCommand(t *testing.T) {
 									"env": {
 										"LINEAR_API_KEY": "test-api-key"
 									},
-									"autoApprove": ["linear_get_initiative", "linear_get_issue", "linear_get_issue_comments", "linear_get_milestone", "linear_get_project", "linear_get_teams", "linear_get_user_issues", "linear_search_issues", "linear_search_projects"],
+									"autoApprove": ["linear_api_metrics", "linear_board", "linear_comment_count", "linear_compare_issues", "linear_completed_since", "linear_cycle_new_issues", "linear_get_comment_thread", "linear_get_drafts", "linear_get_initiative", "linear_get_initiative_updates", "linear_get_issue", "linear_get_issue_ancestors", "linear_get_issue_comments", "linear_get_issue_document", "linear_get_labels", "linear_get_milestone", "linear_get_project", "linear_get_project_issues", "linear_get_team", "linear_get_team_status_options", "linear_get_teams", "linear_get_user_issues", "linear_get_viewer", "linear_issues_by_label", "linear_label_usage", "linear_list_cycles", "linear_list_milestones", "linear_list_projects", "linear_list_users", "linear_mentions", "linear_my_work", "linear_project_timeline", "linear_resolve_issues", "linear_resolve_url", "linear_search_issues", "linear_search_projects", "linear_stale_issues", "linear_team_workload", "linear_wait_for_change"],
 									"disabled": false
 								}
 							}
@@ -171,7 +171,7 @@ func TestSetupCommand(t *testing.T) {
 									"env": {
 										"LINEAR_API_KEY": "test-api-key"
 									},
-									"autoApprove": ["linear_get_initiative", "linear_get_issue", "linear_get_issue_comments", "linear_get_milestone", "linear_get_project", "linear_get_teams", "linear_get_user_issues", "linear_search_issues", "linear_search_projects"],
+									"autoApprove": ["linear_api_metrics", "linear_board", "linear_comment_count", "linear_compare_issues", "linear_completed_since", "linear_cycle_new_issues", "linear_get_comment_thread", "linear_get_drafts", "linear_get_initiative", "linear_get_initiative_updates", "linear_get_issue", "linear_get_issue_ancestors", "linear_get_issue_comments", "linear_get_issue_document", "linear_get_labels", "linear_get_milestone", "linear_get_project", "linear_get_project_issues", "linear_get_team", "linear_get_team_status_options", "linear_get_teams", "linear_get_user_issues", "linear_get_viewer", "linear_issues_by_label", "linear_label_usage", "linear_list_cycles", "linear_list_milestones", "linear_list_projects", "linear_list_users", "linear_mentions", "linear_my_work", "linear_project_timeline", "linear_resolve_issues", "linear_resolve_url", "linear_search_issues", "linear_search_projects", "linear_stale_issues", "linear_team_workload", "linear_wait_for_change"],
 									"disabled": false
 								}
 							}
@@ -239,7 +239,7 @@ func TestSetupCommand(t *testing.T) {
 									"env": {
 										"LINEAR_API_KEY": "test-api-key"
 									},
-									"autoApprove": ["linear_get_initiative", "linear_get_issue", "linear_get_issue_comments", "linear_get_milestone", "linear_get_project", "linear_get_teams", "linear_get_user_issues", "linear_search_issues", "linear_search_projects"],
+									"autoApprove": ["linear_api_metrics", "linear_board", "linear_comment_count", "linear_compare_issues", "linear_completed_since", "linear_cycle_new_issues", "linear_get_comment_thread", "linear_get_drafts", "linear_get_initiative", "linear_get_initiative_updates", "linear_get_issue", "linear_get_issue_ancestors", "linear_get_issue_comments", "linear_get_issue_document", "linear_get_labels", "linear_get_milestone", "linear_get_project", "linear_get_project_issues", "linear_get_team", "linear_get_team_status_options", "linear_get_teams", "linear_get_user_issues", "linear_get_viewer", "linear_issues_by_label", "linear_label_usage", "linear_list_cycles", "linear_list_milestones", "linear_list_projects", "linear_list_users", "linear_mentions", "linear_my_work", "linear_project_timeline", "linear_resolve_issues", "linear_resolve_url", "linear_search_issues", "linear_search_projects", "linear_stale_issues", "linear_team_workload", "linear_wait_for_change"],
 									"disabled": false
 								}
 							},
@@ -856,7 +856,7 @@ func TestSetupCommand(t *testing.T) {
 											"command": "home/mcp-servers/linear-mcp-go",
 											"args": ["serve"],
 											"env": {"LINEAR_API_KEY": "test-api-key"},
-											"autoApprove": ["linear_get_initiative", "linear_get_issue", "linear_get_issue_comments", "linear_get_milestone", "linear_get_project", "linear_get_teams", "linear_get_user_issues", "linear_search_issues", "linear_search_projects"],
+											"autoApprove": ["linear_api_metrics", "linear_board", "linear_comment_count", "linear_compare_issues", "linear_completed_since", "linear_cycle_new_issues", "linear_get_comment_thread", "linear_get_drafts", "linear_get_initiative", "linear_get_initiative_updates", "linear_get_issue", "linear_get_issue_ancestors", "linear_get_issue_comments", "linear_get_issue_document", "linear_get_labels", "linear_get_milestone", "linear_get_project", "linear_get_project_issues", "linear_get_team", "linear_get_team_status_options", "linear_get_teams", "linear_get_user_issues", "linear_get_viewer", "linear_issues_by_label", "linear_label_usage", "linear_list_cycles", "linear_list_milestones", "linear_list_projects", "linear_list_users", "linear_mentions", "linear_my_work", "linear_project_timeline", "linear_resolve_issues", "linear_resolve_url", "linear_search_issues", "linear_search_projects", "linear_stale_issues", "linear_team_workload", "linear_wait_for_change"],
 											"disabled": false
 										},
 										"other-server": {
@@ -980,7 +980,7 @@ func TestSetupCommand(t *testing.T) {
 									"command": "home/mcp-servers/linear-mcp-go",
 									"args": ["serve"],
 									"env": {"LINEAR_API_KEY": "test-api-key"},
-											"autoApprove": ["linear_get_initiative", "linear_get_issue", "linear_get_issue_comments", "linear_get_milestone", "linear_get_project", "linear_get_teams", "linear_get_user_issues", "linear_search_issues", "linear_search_projects"],
+											"autoApprove": ["linear_api_metrics", "linear_board", "linear_comment_count", "linear_compare_issues", "linear_completed_since", "linear_cycle_new_issues", "linear_get_comment_thread", "linear_get_drafts", "linear_get_initiative", "linear_get_initiative_updates", "linear_get_issue", "linear_get_issue_ancestors", "linear_get_issue_comments", "linear_get_issue_document", "linear_get_labels", "linear_get_milestone", "linear_get_project", "linear_get_project_issues", "linear_get_team", "linear_get_team_status_options", "linear_get_teams", "linear_get_user_issues", "linear_get_viewer", "linear_issues_by_label", "linear_label_usage", "linear_list_cycles", "linear_list_milestones", "linear_list_projects", "linear_list_users", "linear_mentions", "linear_my_work", "linear_project_timeline", "linear_resolve_issues", "linear_resolve_url", "linear_search_issues", "linear_search_projects", "linear_stale_issues", "linear_team_workload", "linear_wait_for_change"],
 											"disabled": false
 								},
 								"other-user-server": {
@@ -1286,7 +1286,6 @@ func TestSetupCommand(t *testing.T) {
 				exitCode: 0,
 			},
 		},
-
 	}
 
 	// Run each test case
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// whereCmd represents the where command
+var whereCmd = &cobra.Command{
+	Use:   "where",
+	Short: "Print the config file path(s) the setup command would write",
+	Long: `Print the absolute configuration file path(s) the setup command targets for the specified AI assistant tool(s) on the current OS, without writing anything.
+Useful to debug where a configuration ended up.
+Currently supported tools: cline, roo-code, claude-code, ona`,
+	Run: func(cmd *cobra.Command, args []string) {
+		toolParam, _ := cmd.Flags().GetString("tool")
+		projectPath, _ := cmd.Flags().GetString("project-path")
+
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			fmt.Printf("Error getting user home directory: %v\n", err)
+			os.Exit(1)
+		}
+
+		hasErrors := false
+		for _, t := range strings.Split(toolParam, ",") {
+			t = strings.TrimSpace(t)
+			if t == "" {
+				continue
+			}
+
+			configPath, err := configPathForTool(t, runtime.GOOS, homeDir, projectPath)
+			if err != nil {
+				fmt.Printf("%s: error: %v\n", t, err)
+				hasErrors = true
+				continue
+			}
+			fmt.Printf("%s: %s\n", t, configPath)
+		}
+
+		if hasErrors {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(whereCmd)
+
+	// Add flags to the where command
+	whereCmd.Flags().String("tool", "cline", "The AI assistant tool(s) to print the config path for (comma-separated, e.g., cline,roo-code,claude-code,ona)")
+	whereCmd.Flags().String("project-path", "", "The project path used for ona project-scoped configuration")
+}
+
+// configPathForTool returns the configuration file path the setup command would
+// write for the given tool on the given OS, rooted at homeDir.
+func configPathForTool(tool, goos, homeDir, projectPath string) (string, error) {
+	switch strings.ToLower(tool) {
+	case "cline":
+		configDir, err := vscodeSettingsDir(goos, homeDir, "saoudrizwan.claude-dev")
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(configDir, "cline_mcp_settings.json"), nil
+	case "roo-code":
+		configDir, err := vscodeSettingsDir(goos, homeDir, "rooveterinaryinc.roo-cline")
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(configDir, "cline_mcp_settings.json"), nil
+	case "claude-code":
+		if goos != "linux" {
+			return "", fmt.Errorf("claude-code is only supported on Linux")
+		}
+		return filepath.Join(homeDir, ".claude.json"), nil
+	case "ona":
+		return getOnaConfigPath(projectPath)
+	default:
+		return "", fmt.Errorf("unsupported tool: %s", tool)
+	}
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestConfigPathForTool(t *testing.T) {
+	home := filepath.Join("/", "home", "testuser")
+
+	testCases := []struct {
+		name     string
+		tool     string
+		goos     string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "cline on linux",
+			tool:     "cline",
+			goos:     "linux",
+			expected: filepath.Join(home, ".vscode-server", "data", "User", "globalStorage", "saoudrizwan.claude-dev", "settings", "cline_mcp_settings.json"),
+		},
+		{
+			name:     "cline on darwin",
+			tool:     "cline",
+			goos:     "darwin",
+			expected: filepath.Join(home, "Library", "Application Support", "Code", "User", "globalStorage", "saoudrizwan.claude-dev", "settings", "cline_mcp_settings.json"),
+		},
+		{
+			name:     "cline on windows",
+			tool:     "cline",
+			goos:     "windows",
+			expected: filepath.Join(home, "AppData", "Roaming", "Code", "User", "globalStorage", "saoudrizwan.claude-dev", "settings", "cline_mcp_settings.json"),
+		},
+		{
+			name:     "roo-code on linux",
+			tool:     "roo-code",
+			goos:     "linux",
+			expected: filepath.Join(home, ".vscode-server", "data", "User", "globalStorage", "rooveterinaryinc.roo-cline", "settings", "cline_mcp_settings.json"),
+		},
+		{
+			name:     "roo-code on darwin",
+			tool:     "roo-code",
+			goos:     "darwin",
+			expected: filepath.Join(home, "Library", "Application Support", "Code", "User", "globalStorage", "rooveterinaryinc.roo-cline", "settings", "cline_mcp_settings.json"),
+		},
+		{
+			name:     "claude-code on linux",
+			tool:     "claude-code",
+			goos:     "linux",
+			expected: filepath.Join(home, ".claude.json"),
+		},
+		{
+			name:    "claude-code on darwin",
+			tool:    "claude-code",
+			goos:    "darwin",
+			wantErr: true,
+		},
+		{
+			name:    "cline on unsupported OS",
+			tool:    "cline",
+			goos:    "plan9",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported tool",
+			tool:    "emacs",
+			goos:    "linux",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := configPathForTool(tc.tool, tc.goos, home, "")
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got path %q", path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if path != tc.expected {
+				t.Errorf("expected path %q, got %q", tc.expected, path)
+			}
+		})
+	}
+}
@@ -2,12 +2,15 @@ package linear
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -17,11 +20,22 @@ const (
 	LinearAPIEndpoint = "https://api.linear.app/graphql"
 )
 
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried by default
+	DefaultMaxRetries = 2
+
+	// retryBaseDelay is the backoff before the first retry; it doubles per attempt
+	retryBaseDelay = 500 * time.Millisecond
+)
+
 // LinearClient is a client for the Linear API
 type LinearClient struct {
-	apiKey      string
-	httpClient  *http.Client
-	rateLimiter *RateLimiter
+	apiKey           string
+	httpClient       *http.Client
+	rateLimiter      *RateLimiter
+	maxRetries       int
+	retryBackoffBase time.Duration
+	contentPrefix    string
 
 	serverVersion string
 }
@@ -37,19 +51,54 @@ func NewLinearClient(apiKey string, serverVersion string) (*LinearClient, error)
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		rateLimiter:   NewRateLimiter(1400), // Linear API limit is 1400 requests per hour
-		serverVersion: serverVersion,
+		rateLimiter:      NewRateLimiter(1400, NewLoggerFromEnv()), // Linear API limit is 1400 requests per hour
+		maxRetries:       DefaultMaxRetries,
+		retryBackoffBase: retryBaseDelay,
+		serverVersion:    serverVersion,
 	}, nil
 }
 
+// SetMaxRetries configures how often a failed request is retried. 0 disables retries.
+func (c *LinearClient) SetMaxRetries(maxRetries int) {
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	c.maxRetries = maxRetries
+}
+
+// SetContentPrefix configures a prefix that is prepended to comment bodies and
+// issue descriptions created through this client. Empty disables the prefix.
+func (c *LinearClient) SetContentPrefix(prefix string) {
+	c.contentPrefix = prefix
+}
+
+// applyContentPrefix prepends the configured content prefix to text created
+// through this client. Empty text stays empty so optional fields are not
+// turned into prefix-only content.
+func (c *LinearClient) applyContentPrefix(text string) string {
+	if c.contentPrefix == "" || text == "" {
+		return text
+	}
+	return c.contentPrefix + "\n\n" + text
+}
+
 // NewLinearClientFromEnv creates a new Linear API client from environment variables
 func NewLinearClientFromEnv(serverVersion string) (*LinearClient, error) {
 	apiKey := os.Getenv("LINEAR_API_KEY")
 	return NewLinearClient(apiKey, serverVersion)
 }
 
-// executeGraphQL executes a GraphQL query against the Linear API
+// executeGraphQL executes a GraphQL query against the Linear API without
+// cancellation support; it delegates to executeGraphQLWithContext with a
+// background context.
 func (c *LinearClient) executeGraphQL(query string, variables map[string]interface{}) (*GraphQLResponse, error) {
+	return c.executeGraphQLWithContext(context.Background(), query, variables)
+}
+
+// executeGraphQLWithContext executes a GraphQL query against the Linear API,
+// retrying transient failures up to maxRetries times. Cancelling the context
+// aborts the in-flight HTTP request.
+func (c *LinearClient) executeGraphQLWithContext(ctx context.Context, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
 	// Create the request body
 	reqBody := GraphQLRequest{
 		Query:     query,
@@ -62,10 +111,68 @@ func (c *LinearClient) executeGraphQL(query string, variables map[string]interfa
 		return nil, fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
+	// Execute the request, retrying transient failures with exponential backoff
+	var graphQLResp *GraphQLResponse
+	var retryable bool
+	var retryAfter time.Duration
+	for attempt := 0; ; attempt++ {
+		graphQLResp, retryable, retryAfter, err = c.executeGraphQLOnce(ctx, reqBodyBytes)
+		if err == nil || !retryable || attempt >= c.maxRetries || ctx.Err() != nil {
+			break
+		}
+		time.Sleep(c.retryDelay(attempt, retryAfter))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return graphQLResp, nil
+}
+
+// updateRateLimitFromHeaders parses Linear's rate limit response headers, if
+// present, and feeds them back into the rate limiter.
+func (c *LinearClient) updateRateLimitFromHeaders(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Requests-Remaining"))
+	if err != nil {
+		return
+	}
+
+	var resetAt time.Time
+	if resetMs, parseErr := strconv.ParseInt(header.Get("X-RateLimit-Requests-Reset"), 10, 64); parseErr == nil {
+		// The reset header is a unix timestamp in milliseconds
+		resetAt = time.UnixMilli(resetMs)
+	}
+
+	c.rateLimiter.UpdateFromHeaders(remaining, resetAt)
+}
+
+// retryDelay returns how long to wait before the retry following the given
+// attempt. A server-provided Retry-After value wins; otherwise the delay
+// doubles per attempt starting at retryBackoffBase, with jitter so concurrent
+// clients do not retry in lockstep.
+func (c *LinearClient) retryDelay(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := c.retryBackoffBase << uint(attempt)
+	if delay <= 0 {
+		return 0
+	}
+
+	// Jitter: anywhere between half the delay and the full delay
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// executeGraphQLOnce performs a single HTTP round trip against the Linear API.
+// The returned bool reports whether a failure is worth retrying (transport
+// errors, 5xx responses, and rate limit responses); the duration carries the
+// server's Retry-After wish, if any.
+func (c *LinearClient) executeGraphQLOnce(ctx context.Context, reqBodyBytes []byte) (*GraphQLResponse, bool, time.Duration, error) {
 	// Create the HTTP request
-	req, err := http.NewRequest("POST", LinearAPIEndpoint, bytes.NewBuffer(reqBodyBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", LinearAPIEndpoint, bytes.NewBuffer(reqBodyBytes))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
@@ -82,37 +189,47 @@ func (c *LinearClient) executeGraphQL(query string, variables map[string]interfa
 	}, "graphql")
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return nil, true, 0, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
+	// Feed the server-reported rate limit state back into the rate limiter
+	c.updateRateLimitFromHeaders(resp.Header)
+
 	// Read the response body
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, true, 0, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	// Check for HTTP errors
+	// Check for HTTP errors; non-retryable 4xx responses fail fast
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned non-200 status code: %d, body: %s", resp.StatusCode, string(respBody))
+		retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+
+		var retryAfter time.Duration
+		if seconds, parseErr := strconv.Atoi(resp.Header.Get("Retry-After")); parseErr == nil && seconds > 0 {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+
+		return nil, retryable, retryAfter, fmt.Errorf("API returned non-200 status code: %d, body: %s", resp.StatusCode, string(respBody))
 	}
 
 	// Parse the response
 	var graphQLResp GraphQLResponse
 	if err := json.Unmarshal(respBody, &graphQLResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		return nil, false, 0, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
 	// Check for GraphQL errors
 	if len(graphQLResp.Errors) > 0 {
-		return nil, fmt.Errorf("GraphQL error: %s", graphQLResp.Errors[0].Message)
+		return nil, false, 0, fmt.Errorf("GraphQL error: %s", graphQLResp.Errors[0].Message)
 	}
 
-	return &graphQLResp, nil
+	return &graphQLResp, false, 0, nil
 }
 
 // GetIssue gets an issue by ID
-func (c *LinearClient) GetIssue(issueID string) (*Issue, error) {
+func (c *LinearClient) GetIssue(ctx context.Context, issueID string) (*Issue, error) {
 	query := `
 		query GetIssue($id: String!) {
 			issue(id: $id) {
@@ -155,6 +272,10 @@ func (c *LinearClient) GetIssue(issueID string) (*Issue, error) {
 							identifier
 							title
 							url
+							state {
+								name
+								type
+							}
 						}
 					}
 				}
@@ -167,6 +288,10 @@ func (c *LinearClient) GetIssue(issueID string) (*Issue, error) {
 							identifier
 							title
 							url
+							state {
+								name
+								type
+							}
 						}
 					}
 				}
@@ -189,7 +314,7 @@ func (c *LinearClient) GetIssue(issueID string) (*Issue, error) {
 		"id": issueID,
 	}
 
-	resp, err := c.executeGraphQL(query, variables)
+	resp, err := c.executeGraphQLWithContext(ctx, query, variables)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +339,214 @@ func (c *LinearClient) GetIssue(issueID string) (*Issue, error) {
 	return &issue, nil
 }
 
+// GetIssueCycleHistory gets the cycle assignment history for an issue, i.e. when the issue
+// was moved into which cycle. Entries without a target cycle are filtered out; the
+// history connection is paginated so cycle moves on long-lived issues aren't missed.
+func (c *LinearClient) GetIssueCycleHistory(issueID string) ([]IssueCycleHistoryEntry, error) {
+	query := `
+		query GetIssueCycleHistory($id: String!, $after: String) {
+			issue(id: $id) {
+				history(first: 50, after: $after) {
+					nodes {
+						createdAt
+						toCycle {
+							id
+							number
+							name
+							startsAt
+							endsAt
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	entries := []IssueCycleHistoryEntry{}
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"id": issueID,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		resp, err := c.executeGraphQL(query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		// Extract the issue from the response
+		issueData, ok := resp.Data["issue"].(map[string]interface{})
+		if !ok || issueData == nil {
+			return nil, fmt.Errorf("issue %s not found", issueID)
+		}
+
+		// Extract the history entries
+		historyData, ok := issueData["history"].(map[string]interface{})
+		if !ok || historyData == nil {
+			return entries, nil
+		}
+
+		nodesData, ok := historyData["nodes"].([]interface{})
+		if !ok || nodesData == nil {
+			return entries, nil
+		}
+
+		// Parse the history entries, keeping only those that assigned a cycle
+		for _, nodeData := range nodesData {
+			entryData, ok := nodeData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			var entry IssueCycleHistoryEntry
+			entryBytes, err := json.Marshal(entryData)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal history entry: %w", err)
+			}
+
+			if err := json.Unmarshal(entryBytes, &entry); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal history entry: %w", err)
+			}
+
+			if entry.ToCycle != nil {
+				entries = append(entries, entry)
+			}
+		}
+
+		// Follow pagination until exhausted
+		pageInfoData, ok := historyData["pageInfo"].(map[string]interface{})
+		if !ok || pageInfoData == nil {
+			return entries, nil
+		}
+
+		hasNextPage, _ := pageInfoData["hasNextPage"].(bool)
+		if !hasNextPage {
+			return entries, nil
+		}
+
+		after = getStringValue(pageInfoData, "endCursor")
+		if after == "" {
+			return entries, nil
+		}
+	}
+}
+
+// ListProjects lists projects with optional team and state filters, one page
+// at a time.
+func (c *LinearClient) ListProjects(input ListProjectsInput) (*PaginatedProjectConnection, error) {
+	query := `
+		query ListProjects($filter: ProjectFilter, $first: Int, $after: String) {
+			projects(filter: $filter, first: $first, after: $after) {
+				nodes {
+					id
+					name
+					description
+					slugId
+					state
+					url
+					initiatives(first: 1) {
+						nodes {
+							id
+							name
+						}
+					}
+					lead {
+						id
+						name
+					}
+					startDate
+					targetDate
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	// Build the filter
+	filter := map[string]interface{}{}
+
+	if input.TeamID != "" {
+		filter["accessibleTeams"] = map[string]interface{}{
+			"some": map[string]interface{}{
+				"id": map[string]interface{}{"eq": input.TeamID},
+			},
+		}
+	}
+
+	if input.State != "" {
+		filter["state"] = map[string]interface{}{"eq": input.State}
+	}
+
+	// Set default limit if not provided
+	limit := 10
+	if input.Limit > 0 {
+		limit = input.Limit
+	}
+
+	variables := map[string]interface{}{
+		"filter": filter,
+		"first":  limit,
+	}
+
+	if input.AfterCursor != "" {
+		variables["after"] = input.AfterCursor
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	projectsData, ok := resp.Data["projects"].(map[string]interface{})
+	if !ok || projectsData == nil {
+		return &PaginatedProjectConnection{Nodes: []Project{}}, nil
+	}
+
+	pageInfo := PageInfo{}
+	if pageInfoData, ok := projectsData["pageInfo"].(map[string]interface{}); ok && pageInfoData != nil {
+		if hasNextPage, ok := pageInfoData["hasNextPage"].(bool); ok {
+			pageInfo.HasNextPage = hasNextPage
+		}
+		pageInfo.EndCursor = getStringValue(pageInfoData, "endCursor")
+	}
+
+	nodes, ok := projectsData["nodes"].([]interface{})
+	if !ok {
+		return &PaginatedProjectConnection{Nodes: []Project{}, PageInfo: pageInfo}, nil
+	}
+
+	var projects []Project
+	for _, node := range nodes {
+		projectData, ok := node.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var project Project
+		projectBytes, err := json.Marshal(projectData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal project data: %w", err)
+		}
+
+		if err := json.Unmarshal(projectBytes, &project); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal project data: %w", err)
+		}
+		projects = append(projects, project)
+	}
+
+	return &PaginatedProjectConnection{Nodes: projects, PageInfo: pageInfo}, nil
+}
+
 // GetProject gets a project by identifier (ID, name, or slug)
 func (c *LinearClient) GetProject(identifier string) (*Project, error) {
 	// First, try to get the project by ID
@@ -398,93 +731,207 @@ func (c *LinearClient) getProjectByNameOrSlug(identifier string) (*Project, erro
 	return &project, nil
 }
 
-// SearchProjects searches for projects
-func (c *LinearClient) SearchProjects(query string) ([]Project, error) {
-	graphqlQuery := `
-		query SearchProjects($filter: ProjectFilter) {
-			projects(filter: $filter) {
-				nodes {
-					id
-					name
-					description
-					slugId
-					state
-					url
-					initiatives(first: 1) {
-						nodes {
+// GetProjectIssues gets the active (non-completed) issues of a project
+func (c *LinearClient) GetProjectIssues(projectID string, limit int) ([]Issue, error) {
+	query := `
+		query GetProjectIssues($projectId: String!, $first: Int!) {
+			project(id: $projectId) {
+				issues(first: $first, filter: { state: { type: { nin: ["completed", "canceled"] } } }) {
+					nodes {
+						id
+						identifier
+						title
+						url
+						state {
 							id
 							name
+							type
 						}
 					}
-					lead {
-						id
-						name
-					}
-					startDate
-					targetDate
 				}
 			}
 		}
 	`
 
-	filter := map[string]interface{}{
-		"name": map[string]interface{}{"containsIgnoreCase": query},
+	if limit <= 0 {
+		limit = 50
 	}
 
 	variables := map[string]interface{}{
-		"filter": filter,
+		"projectId": projectID,
+		"first":     limit,
 	}
 
-	resp, err := c.executeGraphQL(graphqlQuery, variables)
+	resp, err := c.executeGraphQL(query, variables)
 	if err != nil {
 		return nil, err
 	}
 
-	projectsData, ok := resp.Data["projects"].(map[string]interface{})
-	if !ok || projectsData == nil {
-		return []Project{}, nil
+	// Extract the project from the response
+	projectData, ok := resp.Data["project"].(map[string]interface{})
+	if !ok || projectData == nil {
+		return nil, fmt.Errorf("project %s not found", projectID)
 	}
 
-	nodes, ok := projectsData["nodes"].([]interface{})
-	if !ok {
-		return []Project{}, nil
+	issuesData, ok := projectData["issues"].(map[string]interface{})
+	if !ok || issuesData == nil {
+		return []Issue{}, nil
 	}
 
-	var projects []Project
-	for _, node := range nodes {
-		projectData, ok := node.(map[string]interface{})
+	nodesData, ok := issuesData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []Issue{}, nil
+	}
+
+	// Parse the issues data
+	issues := make([]Issue, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		issueData, ok := nodeData.(map[string]interface{})
 		if !ok {
 			continue
 		}
 
-		var project Project
-		projectBytes, err := json.Marshal(projectData)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal project data: %w", err)
+		issue := Issue{
+			ID:         getStringValue(issueData, "id"),
+			Identifier: getStringValue(issueData, "identifier"),
+			Title:      getStringValue(issueData, "title"),
+			URL:        getStringValue(issueData, "url"),
 		}
 
-		if err := json.Unmarshal(projectBytes, &project); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal project data: %w", err)
+		if stateData, ok := issueData["state"].(map[string]interface{}); ok && stateData != nil {
+			issue.State = &State{
+				ID:   getStringValue(stateData, "id"),
+				Name: getStringValue(stateData, "name"),
+				Type: getStringValue(stateData, "type"),
+			}
 		}
-		projects = append(projects, project)
+
+		issues = append(issues, issue)
 	}
 
-	return projects, nil
+	return issues, nil
 }
 
-// CreateProject creates a new project.
-func (c *LinearClient) CreateProject(input ProjectCreateInput) (*Project, error) {
+// SubscribeToIssue subscribes the viewer to an issue
+func (c *LinearClient) SubscribeToIssue(issueID string) error {
 	query := `
-		mutation ProjectCreate($input: ProjectCreateInput!) {
-			projectCreate(input: $input) {
+		mutation IssueSubscribe($id: String!) {
+			issueSubscribe(id: $id) {
 				success
-				project {
-					id
-					name
-					description
-					slugId
-					state
-					url
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": issueID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return err
+	}
+
+	// Extract the result from the response
+	subscribeData, ok := resp.Data["issueSubscribe"].(map[string]interface{})
+	if !ok || subscribeData == nil {
+		return errors.New("failed to subscribe to issue")
+	}
+
+	success, ok := subscribeData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to subscribe to issue")
+	}
+
+	return nil
+}
+
+// SearchProjects searches for projects
+func (c *LinearClient) SearchProjects(query string) ([]Project, error) {
+	graphqlQuery := `
+		query SearchProjects($filter: ProjectFilter) {
+			projects(filter: $filter) {
+				nodes {
+					id
+					name
+					description
+					slugId
+					state
+					url
+					initiatives(first: 1) {
+						nodes {
+							id
+							name
+						}
+					}
+					lead {
+						id
+						name
+					}
+					startDate
+					targetDate
+				}
+			}
+		}
+	`
+
+	filter := map[string]interface{}{
+		"name": map[string]interface{}{"containsIgnoreCase": query},
+	}
+
+	variables := map[string]interface{}{
+		"filter": filter,
+	}
+
+	resp, err := c.executeGraphQL(graphqlQuery, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	projectsData, ok := resp.Data["projects"].(map[string]interface{})
+	if !ok || projectsData == nil {
+		return []Project{}, nil
+	}
+
+	nodes, ok := projectsData["nodes"].([]interface{})
+	if !ok {
+		return []Project{}, nil
+	}
+
+	var projects []Project
+	for _, node := range nodes {
+		projectData, ok := node.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var project Project
+		projectBytes, err := json.Marshal(projectData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal project data: %w", err)
+		}
+
+		if err := json.Unmarshal(projectBytes, &project); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal project data: %w", err)
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+// CreateProject creates a new project.
+func (c *LinearClient) CreateProject(input ProjectCreateInput) (*Project, error) {
+	query := `
+		mutation ProjectCreate($input: ProjectCreateInput!) {
+			projectCreate(input: $input) {
+				success
+				project {
+					id
+					name
+					description
+					slugId
+					state
+					url
 				}
 			}
 		}
@@ -583,6 +1030,113 @@ func (c *LinearClient) UpdateProject(id string, input ProjectUpdateInput) (*Proj
 	return &project, nil
 }
 
+// updateProjectMembers replaces a project's member list via projectUpdate.
+func (c *LinearClient) updateProjectMembers(projectID string, memberIDs []string) (*Project, error) {
+	query := `
+		mutation ProjectUpdateMembers($id: String!, $input: ProjectUpdateInput!) {
+			projectUpdate(id: $id, input: $input) {
+				success
+				project {
+					id
+					name
+					members {
+						nodes {
+							id
+							name
+							email
+						}
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": projectID,
+		"input": map[string]interface{}{
+			"memberIds": memberIDs,
+		},
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUpdateData, ok := resp.Data["projectUpdate"].(map[string]interface{})
+	if !ok || projectUpdateData == nil {
+		return nil, errors.New("failed to update project members")
+	}
+
+	success, ok := projectUpdateData["success"].(bool)
+	if !ok || !success {
+		return nil, errors.New("failed to update project members")
+	}
+
+	projectData, ok := projectUpdateData["project"].(map[string]interface{})
+	if !ok || projectData == nil {
+		return nil, errors.New("failed to update project members")
+	}
+
+	var project Project
+	projectBytes, err := json.Marshal(projectData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal project data: %w", err)
+	}
+
+	if err := json.Unmarshal(projectBytes, &project); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal project data: %w", err)
+	}
+
+	return &project, nil
+}
+
+// AddProjectMember adds a user to a project's member list.
+func (c *LinearClient) AddProjectMember(projectIdentifier, userID string) (*Project, error) {
+	project, err := c.GetProject(projectIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := []string{}
+	if project.Members != nil {
+		for _, member := range project.Members.Nodes {
+			if member.ID == userID {
+				return nil, fmt.Errorf("user %s is already a member of project %s", userID, project.Name)
+			}
+			memberIDs = append(memberIDs, member.ID)
+		}
+	}
+	memberIDs = append(memberIDs, userID)
+
+	return c.updateProjectMembers(project.ID, memberIDs)
+}
+
+// RemoveProjectMember removes a user from a project's member list.
+func (c *LinearClient) RemoveProjectMember(projectIdentifier, userID string) (*Project, error) {
+	project, err := c.GetProject(projectIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs := []string{}
+	found := false
+	if project.Members != nil {
+		for _, member := range project.Members.Nodes {
+			if member.ID == userID {
+				found = true
+				continue
+			}
+			memberIDs = append(memberIDs, member.ID)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("user %s is not a member of project %s", userID, project.Name)
+	}
+
+	return c.updateProjectMembers(project.ID, memberIDs)
+}
+
 // GetMilestone gets a project milestone by identifier (ID or name).
 func (c *LinearClient) GetMilestone(identifier string) (*ProjectMilestone, error) {
 	// First, try to get the milestone by ID
@@ -607,6 +1161,7 @@ func (c *LinearClient) getMilestoneByID(id string) (*ProjectMilestone, error) {
 				project {
 					id
 					name
+					url
 				}
 			}
 		}
@@ -639,31 +1194,26 @@ func (c *LinearClient) getMilestoneByID(id string) (*ProjectMilestone, error) {
 	return &milestone, nil
 }
 
-// getMilestoneByName gets a project milestone by its name.
-func (c *LinearClient) getMilestoneByName(name string) (*ProjectMilestone, error) {
+// GetProjectMilestones lists a project's milestones, sorted by sort order.
+func (c *LinearClient) GetProjectMilestones(projectID string) ([]ProjectMilestone, error) {
 	query := `
-		query GetMilestoneByName($filter: ProjectMilestoneFilter) {
-			projectMilestones(filter: $filter, first: 1) {
-				nodes {
-					id
-					name
-					description
-					targetDate
-					project {
+		query GetProjectMilestones($id: String!) {
+			project(id: $id) {
+				projectMilestones {
+					nodes {
 						id
 						name
+						description
+						targetDate
+						sortOrder
 					}
 				}
 			}
 		}
 	`
 
-	filter := map[string]interface{}{
-		"name": map[string]interface{}{"eq": name},
-	}
-
 	variables := map[string]interface{}{
-		"filter": filter,
+		"id": projectID,
 	}
 
 	resp, err := c.executeGraphQL(query, variables)
@@ -671,62 +1221,225 @@ func (c *LinearClient) getMilestoneByName(name string) (*ProjectMilestone, error
 		return nil, err
 	}
 
-	milestonesData, ok := resp.Data["projectMilestones"].(map[string]interface{})
-	if !ok || milestonesData == nil {
-		return nil, fmt.Errorf("milestone with name '%s' not found", name)
+	projectData, ok := resp.Data["project"].(map[string]interface{})
+	if !ok || projectData == nil {
+		return nil, fmt.Errorf("project with ID %s not found", projectID)
 	}
 
-	nodes, ok := milestonesData["nodes"].([]interface{})
-	if !ok || len(nodes) == 0 {
-		return nil, fmt.Errorf("milestone with name '%s' not found", name)
+	milestonesData, ok := projectData["projectMilestones"].(map[string]interface{})
+	if !ok || milestonesData == nil {
+		return []ProjectMilestone{}, nil
 	}
 
-	milestoneData, ok := nodes[0].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("failed to parse milestone data for name '%s'", name)
+	nodesData, ok := milestonesData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []ProjectMilestone{}, nil
 	}
 
-	var milestone ProjectMilestone
-	milestoneBytes, err := json.Marshal(milestoneData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal milestone data: %w", err)
-	}
+	milestones := make([]ProjectMilestone, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		milestoneData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
 
-	if err := json.Unmarshal(milestoneBytes, &milestone); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal milestone data: %w", err)
+		var milestone ProjectMilestone
+		milestoneBytes, err := json.Marshal(milestoneData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal milestone data: %w", err)
+		}
+
+		if err := json.Unmarshal(milestoneBytes, &milestone); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal milestone data: %w", err)
+		}
+
+		milestones = append(milestones, milestone)
 	}
 
-	return &milestone, nil
+	// The API does not guarantee ordering, so sort by the board's sort order
+	sort.Slice(milestones, func(i, j int) bool {
+		return milestones[i].SortOrder < milestones[j].SortOrder
+	})
+
+	return milestones, nil
 }
 
-// UpdateMilestone updates an existing project milestone.
-func (c *LinearClient) UpdateMilestone(id string, input ProjectMilestoneUpdateInput) (*ProjectMilestone, error) {
+// GetMilestoneIssueCounts returns how many issues a milestone has in total and
+// how many of those are in a completed state, following pagination so the
+// counts stay exact.
+func (c *LinearClient) GetMilestoneIssueCounts(milestoneID string) (int, int, error) {
 	query := `
-		mutation ProjectMilestoneUpdate($id: String!, $input: ProjectMilestoneUpdateInput!) {
-			projectMilestoneUpdate(id: $id, input: $input) {
-				success
-				projectMilestone {
+		query GetMilestoneIssueCounts($filter: IssueFilter, $first: Int, $after: String) {
+			issues(filter: $filter, first: $first, after: $after) {
+				nodes {
 					id
-					name
-					description
-					targetDate
-					project {
-						id
-						name
+					state {
+						type
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
 
-	variables := map[string]interface{}{
-		"id":    id,
-		"input": input,
-	}
+	total := 0
+	completed := 0
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"filter": map[string]interface{}{
+				"projectMilestone": map[string]interface{}{
+					"id": map[string]interface{}{"eq": milestoneID},
+				},
+			},
+			"first": 250,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
 
-	resp, err := c.executeGraphQL(query, variables)
-	if err != nil {
-		return nil, err
+		resp, err := c.executeGraphQL(query, variables)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		// Extract the issues from the response
+		issuesData, ok := resp.Data["issues"].(map[string]interface{})
+		if !ok || issuesData == nil {
+			return total, completed, nil
+		}
+
+		nodesData, ok := issuesData["nodes"].([]interface{})
+		if !ok || nodesData == nil {
+			return total, completed, nil
+		}
+
+		// Count the issues by completion
+		for _, nodeData := range nodesData {
+			issueData, ok := nodeData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			total++
+			if stateData, ok := issueData["state"].(map[string]interface{}); ok && stateData != nil {
+				if stateType, ok := stateData["type"].(string); ok && stateType == "completed" {
+					completed++
+				}
+			}
+		}
+
+		// Follow pagination until exhausted
+		pageInfoData, ok := issuesData["pageInfo"].(map[string]interface{})
+		if !ok || pageInfoData == nil {
+			return total, completed, nil
+		}
+
+		hasNextPage, _ := pageInfoData["hasNextPage"].(bool)
+		if !hasNextPage {
+			return total, completed, nil
+		}
+
+		after = getStringValue(pageInfoData, "endCursor")
+		if after == "" {
+			return total, completed, nil
+		}
+	}
+}
+
+// getMilestoneByName gets a project milestone by its name.
+func (c *LinearClient) getMilestoneByName(name string) (*ProjectMilestone, error) {
+	query := `
+		query GetMilestoneByName($filter: ProjectMilestoneFilter) {
+			projectMilestones(filter: $filter, first: 1) {
+				nodes {
+					id
+					name
+					description
+					targetDate
+					project {
+						id
+						name
+						url
+					}
+				}
+			}
+		}
+	`
+
+	filter := map[string]interface{}{
+		"name": map[string]interface{}{"eq": name},
+	}
+
+	variables := map[string]interface{}{
+		"filter": filter,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	milestonesData, ok := resp.Data["projectMilestones"].(map[string]interface{})
+	if !ok || milestonesData == nil {
+		return nil, fmt.Errorf("milestone with name '%s' not found", name)
+	}
+
+	nodes, ok := milestonesData["nodes"].([]interface{})
+	if !ok || len(nodes) == 0 {
+		return nil, fmt.Errorf("milestone with name '%s' not found", name)
+	}
+
+	milestoneData, ok := nodes[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("failed to parse milestone data for name '%s'", name)
+	}
+
+	var milestone ProjectMilestone
+	milestoneBytes, err := json.Marshal(milestoneData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal milestone data: %w", err)
+	}
+
+	if err := json.Unmarshal(milestoneBytes, &milestone); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal milestone data: %w", err)
+	}
+
+	return &milestone, nil
+}
+
+// UpdateMilestone updates an existing project milestone.
+func (c *LinearClient) UpdateMilestone(id string, input ProjectMilestoneUpdateInput) (*ProjectMilestone, error) {
+	query := `
+		mutation ProjectMilestoneUpdate($id: String!, $input: ProjectMilestoneUpdateInput!) {
+			projectMilestoneUpdate(id: $id, input: $input) {
+				success
+				projectMilestone {
+					id
+					name
+					description
+					targetDate
+					project {
+						id
+						name
+						url
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
 	}
 
 	milestoneUpdateData, ok := resp.Data["projectMilestoneUpdate"].(map[string]interface{})
@@ -771,6 +1484,7 @@ func (c *LinearClient) CreateMilestone(input ProjectMilestoneCreateInput) (*Proj
 					project {
 						id
 						name
+						url
 					}
 				}
 			}
@@ -922,6 +1636,64 @@ func (c *LinearClient) getInitiativeByName(name string) (*Initiative, error) {
 	return &initiative, nil
 }
 
+// GetInitiativeUpdates gets the update feed of an initiative by its UUID
+func (c *LinearClient) GetInitiativeUpdates(initiativeID string) ([]InitiativeUpdate, error) {
+	query := `
+		query GetInitiativeUpdates($id: String!) {
+			initiative(id: $id) {
+				initiativeUpdates {
+					nodes {
+						id
+						body
+						health
+						createdAt
+						user {
+							id
+							name
+						}
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": initiativeID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	initiativeData, ok := resp.Data["initiative"].(map[string]interface{})
+	if !ok || initiativeData == nil {
+		return nil, fmt.Errorf("initiative with ID %s not found", initiativeID)
+	}
+
+	updatesData, ok := initiativeData["initiativeUpdates"].(map[string]interface{})
+	if !ok || updatesData == nil {
+		return []InitiativeUpdate{}, nil
+	}
+
+	nodes, ok := updatesData["nodes"].([]interface{})
+	if !ok {
+		return []InitiativeUpdate{}, nil
+	}
+
+	var updates []InitiativeUpdate
+	updatesBytes, err := json.Marshal(nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal initiative updates data: %w", err)
+	}
+
+	if err := json.Unmarshal(updatesBytes, &updates); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal initiative updates data: %w", err)
+	}
+
+	return updates, nil
+}
+
 // UpdateInitiative updates an existing initiative.
 func (c *LinearClient) UpdateInitiative(id string, input InitiativeUpdateInput) (*Initiative, error) {
 	query := `
@@ -1029,6 +1801,123 @@ func (c *LinearClient) CreateInitiative(input InitiativeCreateInput) (*Initiativ
 	return &initiative, nil
 }
 
+// GetIssueParent gets the parent of an issue, or nil for top-level issues.
+func (c *LinearClient) GetIssueParent(issueID string) (*Issue, error) {
+	query := `
+		query GetIssueParent($id: String!) {
+			issue(id: $id) {
+				parent {
+					id
+					identifier
+					title
+					state {
+						id
+						name
+						type
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": issueID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	issueData, ok := resp.Data["issue"].(map[string]interface{})
+	if !ok || issueData == nil {
+		return nil, fmt.Errorf("issue with ID %s not found", issueID)
+	}
+
+	parentData, ok := issueData["parent"].(map[string]interface{})
+	if !ok || parentData == nil {
+		return nil, nil
+	}
+
+	parentBytes, err := json.Marshal(parentData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal parent data: %w", err)
+	}
+
+	var parent Issue
+	if err := json.Unmarshal(parentBytes, &parent); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal parent data: %w", err)
+	}
+
+	return &parent, nil
+}
+
+// GetIssueCommentCount returns the number of comments on an issue without
+// fetching their bodies, following pagination so the count stays exact.
+func (c *LinearClient) GetIssueCommentCount(issueID string) (int, error) {
+	query := `
+		query GetIssueCommentCount($id: String!, $after: String) {
+			issue(id: $id) {
+				comments(first: 250, after: $after) {
+					nodes {
+						id
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	count := 0
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"id": issueID,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		resp, err := c.executeGraphQL(query, variables)
+		if err != nil {
+			return 0, err
+		}
+
+		issueData, ok := resp.Data["issue"].(map[string]interface{})
+		if !ok || issueData == nil {
+			return 0, fmt.Errorf("issue with ID %s not found", issueID)
+		}
+
+		commentsData, ok := issueData["comments"].(map[string]interface{})
+		if !ok || commentsData == nil {
+			return count, nil
+		}
+
+		if nodesData, ok := commentsData["nodes"].([]interface{}); ok {
+			count += len(nodesData)
+		}
+
+		// Follow pagination until exhausted
+		pageInfoData, ok := commentsData["pageInfo"].(map[string]interface{})
+		if !ok || pageInfoData == nil {
+			return count, nil
+		}
+
+		hasNextPage, _ := pageInfoData["hasNextPage"].(bool)
+		if !hasNextPage {
+			return count, nil
+		}
+
+		after = getStringValue(pageInfoData, "endCursor")
+		if after == "" {
+			return count, nil
+		}
+	}
+}
+
 // GetIssueComments gets paginated comments for an issue
 func (c *LinearClient) GetIssueComments(input GetIssueCommentsInput) (*PaginatedCommentConnection, error) {
 	query := `
@@ -1043,6 +1932,7 @@ func (c *LinearClient) GetIssueComments(input GetIssueCommentsInput) (*Paginated
 						id
 						body
 						createdAt
+						editedAt
 						user {
 							id
 							name
@@ -1119,31 +2009,271 @@ func (c *LinearClient) GetIssueComments(input GetIssueCommentsInput) (*Paginated
 	return &paginatedComments, nil
 }
 
-// GetIssueByIdentifier gets an issue by its identifier (e.g., "TEAM-123")
-func (c *LinearClient) GetIssueByIdentifier(identifier string) (*Issue, error) {
-	// Split the identifier into team key and number parts
-	parts := strings.Split(identifier, "-")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid issue identifier format: %s (expected format: TEAM-123)", identifier)
-	}
-
-	teamKey := parts[0]
-	numberStr := parts[1]
-
-	// Convert the number part to an integer
-	number, err := strconv.Atoi(numberStr)
-	if err != nil {
-		return nil, fmt.Errorf("invalid issue number in identifier: %s", identifier)
-	}
-
-	// Use the issues query with filters for team key and number
+// GetComment gets a single comment with references to its direct replies
+func (c *LinearClient) GetComment(commentID string) (*Comment, error) {
 	query := `
-		query GetIssueByIdentifier($teamKey: String!, $number: Float!) {
-			issues(filter: { team: { key: { eq: $teamKey } }, number: { eq: $number } }, first: 1) {
-				nodes {
+		query GetComment($id: String!) {
+			comment(id: $id) {
+				id
+				body
+				url
+				createdAt
+				editedAt
+				user {
 					id
-					identifier
-					title
+					name
+				}
+				children(first: 50) {
+					nodes {
+						id
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": commentID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the comment from the response
+	commentData, ok := resp.Data["comment"].(map[string]interface{})
+	if !ok || commentData == nil {
+		return nil, fmt.Errorf("comment %s not found", commentID)
+	}
+
+	// Parse the comment data
+	var comment Comment
+	commentBytes, err := json.Marshal(commentData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal comment data: %w", err)
+	}
+
+	if err := json.Unmarshal(commentBytes, &comment); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal comment data: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// GetIssueLabels gets the labels currently attached to an issue
+func (c *LinearClient) GetIssueLabels(issueID string) ([]Label, error) {
+	query := `
+		query GetIssueLabels($id: String!) {
+			issue(id: $id) {
+				labels {
+					nodes {
+						id
+						name
+						color
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": issueID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the issue from the response
+	issueData, ok := resp.Data["issue"].(map[string]interface{})
+	if !ok || issueData == nil {
+		return nil, fmt.Errorf("issue %s not found", issueID)
+	}
+
+	// Extract the labels
+	labelsData, ok := issueData["labels"].(map[string]interface{})
+	if !ok || labelsData == nil {
+		return []Label{}, nil
+	}
+
+	nodesData, ok := labelsData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []Label{}, nil
+	}
+
+	// Parse the labels data
+	labels := make([]Label, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		labelData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		labels = append(labels, Label{
+			ID:    getStringValue(labelData, "id"),
+			Name:  getStringValue(labelData, "name"),
+			Color: getStringValue(labelData, "color"),
+		})
+	}
+
+	return labels, nil
+}
+
+// GetIntegrations gets the services integrated with the workspace (e.g. "github", "slack")
+func (c *LinearClient) GetIntegrations() ([]string, error) {
+	query := `
+		query GetIntegrations {
+			integrations {
+				nodes {
+					id
+					service
+				}
+			}
+		}
+	`
+
+	resp, err := c.executeGraphQL(query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the integrations from the response
+	integrationsData, ok := resp.Data["integrations"].(map[string]interface{})
+	if !ok || integrationsData == nil {
+		return []string{}, nil
+	}
+
+	nodesData, ok := integrationsData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []string{}, nil
+	}
+
+	// Collect the service names
+	services := make([]string, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		integrationData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if service := getStringValue(integrationData, "service"); service != "" {
+			services = append(services, service)
+		}
+	}
+
+	return services, nil
+}
+
+// HasIntegration reports whether the workspace has the given service integrated.
+// Attachment and PR tooling uses this to surface setup gaps instead of opaque API errors.
+func (c *LinearClient) HasIntegration(service string) (bool, error) {
+	services, err := c.GetIntegrations()
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range services {
+		if strings.EqualFold(s, service) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ResolveComment marks a comment thread as resolved
+func (c *LinearClient) ResolveComment(commentID string) error {
+	query := `
+		mutation CommentResolve($id: String!) {
+			commentResolve(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": commentID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return err
+	}
+
+	// Extract the result from the response
+	commentResolveData, ok := resp.Data["commentResolve"].(map[string]interface{})
+	if !ok || commentResolveData == nil {
+		return errors.New("failed to resolve comment")
+	}
+
+	success, ok := commentResolveData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to resolve comment")
+	}
+
+	return nil
+}
+
+// UnresolveComment marks a comment thread as unresolved
+func (c *LinearClient) UnresolveComment(commentID string) error {
+	query := `
+		mutation CommentUnresolve($id: String!) {
+			commentUnresolve(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": commentID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return err
+	}
+
+	// Extract the result from the response
+	commentUnresolveData, ok := resp.Data["commentUnresolve"].(map[string]interface{})
+	if !ok || commentUnresolveData == nil {
+		return errors.New("failed to unresolve comment")
+	}
+
+	success, ok := commentUnresolveData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to unresolve comment")
+	}
+
+	return nil
+}
+
+// GetIssueByIdentifier gets an issue by its identifier (e.g., "TEAM-123")
+func (c *LinearClient) GetIssueByIdentifier(identifier string) (*Issue, error) {
+	// Split the identifier into team key and number parts
+	parts := strings.Split(identifier, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid issue identifier format: %s (expected format: TEAM-123)", identifier)
+	}
+
+	teamKey := parts[0]
+	numberStr := parts[1]
+
+	// Convert the number part to an integer
+	number, err := strconv.Atoi(numberStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue number in identifier: %s", identifier)
+	}
+
+	// Use the issues query with filters for team key and number
+	query := `
+		query GetIssueByIdentifier($teamKey: String!, $number: Float!) {
+			issues(filter: { team: { key: { eq: $teamKey } }, number: { eq: $number } }, first: 1) {
+				nodes {
+					id
+					identifier
+					title
 				}
 			}
 		}
@@ -1180,25 +2310,1456 @@ func (c *LinearClient) GetIssueByIdentifier(identifier string) (*Issue, error) {
 	var issue Issue
 	issueBytes, err := json.Marshal(issueData)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+		return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+	}
+
+	if err := json.Unmarshal(issueBytes, &issue); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+	}
+
+	return &issue, nil
+}
+
+// GetLabelsByName gets labels by name for a team
+func (c *LinearClient) GetLabelsByName(teamID string, labelNames []string) ([]Label, error) {
+	query := `
+		query GetLabelsByName($teamId: String!, $names: [String!]!) {
+			team(id: $teamId) {
+				labels(filter: { name: { in: $names } }) {
+					nodes {
+						id
+						name
+						color
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"teamId": teamID,
+		"names":  labelNames,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the team from the response
+	teamData, ok := resp.Data["team"].(map[string]interface{})
+	if !ok || teamData == nil {
+		return nil, fmt.Errorf("team %s not found", teamID)
+	}
+
+	// Extract the labels
+	labelsData, ok := teamData["labels"].(map[string]interface{})
+	if !ok || labelsData == nil {
+		return []Label{}, nil
+	}
+
+	nodesData, ok := labelsData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []Label{}, nil
+	}
+
+	// Parse the labels data
+	labels := make([]Label, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		labelData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		label := Label{
+			ID:    getStringValue(labelData, "id"),
+			Name:  getStringValue(labelData, "name"),
+			Color: getStringValue(labelData, "color"),
+		}
+
+		labels = append(labels, label)
+	}
+
+	return labels, nil
+}
+
+// GetTeamLabels gets all labels of a team, following pagination until exhausted
+func (c *LinearClient) GetTeamLabels(teamID string) ([]Label, error) {
+	query := `
+		query GetTeamLabels($teamId: String!, $after: String) {
+			team(id: $teamId) {
+				labels(first: 100, after: $after) {
+					nodes {
+						id
+						name
+						color
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	labels := []Label{}
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"teamId": teamID,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		resp, err := c.executeGraphQL(query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		// Extract the team from the response
+		teamData, ok := resp.Data["team"].(map[string]interface{})
+		if !ok || teamData == nil {
+			return nil, fmt.Errorf("team %s not found", teamID)
+		}
+
+		// Extract the labels
+		labelsData, ok := teamData["labels"].(map[string]interface{})
+		if !ok || labelsData == nil {
+			return labels, nil
+		}
+
+		nodesData, ok := labelsData["nodes"].([]interface{})
+		if !ok || nodesData == nil {
+			return labels, nil
+		}
+
+		// Parse the labels data
+		for _, nodeData := range nodesData {
+			labelData, ok := nodeData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			labels = append(labels, Label{
+				ID:    getStringValue(labelData, "id"),
+				Name:  getStringValue(labelData, "name"),
+				Color: getStringValue(labelData, "color"),
+			})
+		}
+
+		// Follow pagination until exhausted
+		pageInfoData, ok := labelsData["pageInfo"].(map[string]interface{})
+		if !ok || pageInfoData == nil {
+			return labels, nil
+		}
+
+		hasNextPage, _ := pageInfoData["hasNextPage"].(bool)
+		if !hasNextPage {
+			return labels, nil
+		}
+
+		after = getStringValue(pageInfoData, "endCursor")
+		if after == "" {
+			return labels, nil
+		}
+	}
+}
+
+// CreateLabel creates a new issue label in a team.
+func (c *LinearClient) CreateLabel(input LabelCreateInput) (*Label, error) {
+	query := `
+		mutation IssueLabelCreate($input: IssueLabelCreateInput!) {
+			issueLabelCreate(input: $input) {
+				success
+				issueLabel {
+					id
+					name
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	labelCreateData, ok := resp.Data["issueLabelCreate"].(map[string]interface{})
+	if !ok || labelCreateData == nil {
+		return nil, errors.New("failed to create label")
+	}
+
+	success, ok := labelCreateData["success"].(bool)
+	if !ok || !success {
+		return nil, errors.New("failed to create label")
+	}
+
+	labelData, ok := labelCreateData["issueLabel"].(map[string]interface{})
+	if !ok || labelData == nil {
+		return nil, errors.New("failed to create label")
+	}
+
+	return &Label{
+		ID:   getStringValue(labelData, "id"),
+		Name: getStringValue(labelData, "name"),
+	}, nil
+}
+
+// DeleteLabel deletes an issue label
+func (c *LinearClient) DeleteLabel(labelID string) error {
+	query := `
+		mutation IssueLabelDelete($id: String!) {
+			issueLabelDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": labelID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return err
+	}
+
+	// Extract the result from the response
+	labelDeleteData, ok := resp.Data["issueLabelDelete"].(map[string]interface{})
+	if !ok || labelDeleteData == nil {
+		return errors.New("failed to delete label")
+	}
+
+	success, ok := labelDeleteData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to delete label")
+	}
+
+	return nil
+}
+
+// SetIssueLabels replaces the label set of an issue.
+func (c *LinearClient) SetIssueLabels(issueID string, labelIDs []string) (*Issue, error) {
+	query := `
+		mutation IssueSetLabels($id: String!, $input: IssueUpdateInput!) {
+			issueUpdate(id: $id, input: $input) {
+				success
+				issue {
+					id
+					identifier
+					title
+					url
+					labels {
+						nodes {
+							id
+							name
+							color
+						}
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": issueID,
+		"input": map[string]interface{}{
+			"labelIds": labelIDs,
+		},
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	issueUpdateData, ok := resp.Data["issueUpdate"].(map[string]interface{})
+	if !ok || issueUpdateData == nil {
+		return nil, errors.New("failed to set issue labels")
+	}
+
+	success, ok := issueUpdateData["success"].(bool)
+	if !ok || !success {
+		return nil, errors.New("failed to set issue labels")
+	}
+
+	issueData, ok := issueUpdateData["issue"].(map[string]interface{})
+	if !ok || issueData == nil {
+		return nil, errors.New("failed to set issue labels")
+	}
+
+	var issue Issue
+	issueBytes, err := json.Marshal(issueData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+	}
+
+	if err := json.Unmarshal(issueBytes, &issue); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+	}
+
+	return &issue, nil
+}
+
+// GetLabelUsage gets all workspace issue labels together with the number of issues
+// using them, following pagination on both levels so counts stay exact
+func (c *LinearClient) GetLabelUsage() ([]LabelUsage, error) {
+	query := `
+		query GetLabelUsage($after: String) {
+			issueLabels(first: 100, after: $after) {
+				nodes {
+					id
+					name
+					issues(first: 250) {
+						nodes {
+							id
+						}
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	usage := []LabelUsage{}
+	after := ""
+	for {
+		variables := map[string]interface{}{}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		resp, err := c.executeGraphQL(query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		// Extract the labels from the response
+		labelsData, ok := resp.Data["issueLabels"].(map[string]interface{})
+		if !ok || labelsData == nil {
+			return usage, nil
+		}
+
+		nodesData, ok := labelsData["nodes"].([]interface{})
+		if !ok || nodesData == nil {
+			return usage, nil
+		}
+
+		// Parse the labels data, counting the issues per label
+		for _, nodeData := range nodesData {
+			labelData, ok := nodeData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			entry := LabelUsage{
+				Label: Label{
+					ID:   getStringValue(labelData, "id"),
+					Name: getStringValue(labelData, "name"),
+				},
+			}
+
+			if issuesData, ok := labelData["issues"].(map[string]interface{}); ok && issuesData != nil {
+				if issueNodes, ok := issuesData["nodes"].([]interface{}); ok {
+					entry.IssueCount = len(issueNodes)
+				}
+
+				// Busy labels overflow the first issue page; keep counting
+				// page by page so the report doesn't silently cap at 250
+				if pageInfoData, ok := issuesData["pageInfo"].(map[string]interface{}); ok && pageInfoData != nil {
+					if hasNextPage, _ := pageInfoData["hasNextPage"].(bool); hasNextPage {
+						more, err := c.countLabelIssues(entry.ID, getStringValue(pageInfoData, "endCursor"))
+						if err != nil {
+							return nil, err
+						}
+						entry.IssueCount += more
+					}
+				}
+			}
+
+			usage = append(usage, entry)
+		}
+
+		// Follow pagination until exhausted
+		pageInfoData, ok := labelsData["pageInfo"].(map[string]interface{})
+		if !ok || pageInfoData == nil {
+			return usage, nil
+		}
+
+		hasNextPage, _ := pageInfoData["hasNextPage"].(bool)
+		if !hasNextPage {
+			return usage, nil
+		}
+
+		after = getStringValue(pageInfoData, "endCursor")
+		if after == "" {
+			return usage, nil
+		}
+	}
+}
+
+// countLabelIssues counts the issues of a single label starting after the given
+// cursor, following pagination until exhausted
+func (c *LinearClient) countLabelIssues(labelID, after string) (int, error) {
+	query := `
+		query CountLabelIssues($labelId: String!, $after: String) {
+			issueLabel(id: $labelId) {
+				issues(first: 250, after: $after) {
+					nodes {
+						id
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	count := 0
+	for {
+		variables := map[string]interface{}{
+			"labelId": labelID,
+			"after":   after,
+		}
+
+		resp, err := c.executeGraphQL(query, variables)
+		if err != nil {
+			return 0, err
+		}
+
+		// Extract the label from the response
+		labelData, ok := resp.Data["issueLabel"].(map[string]interface{})
+		if !ok || labelData == nil {
+			return count, nil
+		}
+
+		issuesData, ok := labelData["issues"].(map[string]interface{})
+		if !ok || issuesData == nil {
+			return count, nil
+		}
+
+		if issueNodes, ok := issuesData["nodes"].([]interface{}); ok {
+			count += len(issueNodes)
+		}
+
+		// Follow pagination until exhausted
+		pageInfoData, ok := issuesData["pageInfo"].(map[string]interface{})
+		if !ok || pageInfoData == nil {
+			return count, nil
+		}
+
+		hasNextPage, _ := pageInfoData["hasNextPage"].(bool)
+		if !hasNextPage {
+			return count, nil
+		}
+
+		after = getStringValue(pageInfoData, "endCursor")
+		if after == "" {
+			return count, nil
+		}
+	}
+}
+
+// GetTeamIssuesByLabel lists the labels of a team together with the issues
+// carrying them, following pagination on both levels: labels beyond the first
+// page are fetched too, and issue counts stay exact even when only the first
+// issue page is materialized.
+func (c *LinearClient) GetTeamIssuesByLabel(teamID string) ([]LabelIssues, error) {
+	query := `
+		query GetTeamIssuesByLabel($teamId: String!, $after: String) {
+			team(id: $teamId) {
+				labels(first: 100, after: $after) {
+					nodes {
+						id
+						name
+						issues(first: 250) {
+							nodes {
+								id
+								identifier
+								title
+							}
+							pageInfo {
+								hasNextPage
+								endCursor
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	result := []LabelIssues{}
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"teamId": teamID,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		resp, err := c.executeGraphQL(query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		// Extract the team from the response
+		teamData, ok := resp.Data["team"].(map[string]interface{})
+		if !ok || teamData == nil {
+			return nil, fmt.Errorf("team %s not found", teamID)
+		}
+
+		labelsData, ok := teamData["labels"].(map[string]interface{})
+		if !ok || labelsData == nil {
+			return result, nil
+		}
+
+		nodesData, ok := labelsData["nodes"].([]interface{})
+		if !ok || nodesData == nil {
+			return result, nil
+		}
+
+		// Parse the labels data, collecting the issues per label
+		for _, nodeData := range nodesData {
+			labelData, ok := nodeData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			entry := LabelIssues{
+				Label: Label{
+					ID:   getStringValue(labelData, "id"),
+					Name: getStringValue(labelData, "name"),
+				},
+				Issues: []Issue{},
+			}
+
+			if issuesData, ok := labelData["issues"].(map[string]interface{}); ok && issuesData != nil {
+				if issueNodes, ok := issuesData["nodes"].([]interface{}); ok {
+					for _, issueNode := range issueNodes {
+						issueData, ok := issueNode.(map[string]interface{})
+						if !ok {
+							continue
+						}
+						entry.Issues = append(entry.Issues, Issue{
+							ID:         getStringValue(issueData, "id"),
+							Identifier: getStringValue(issueData, "identifier"),
+							Title:      getStringValue(issueData, "title"),
+						})
+					}
+				}
+				entry.IssueCount = len(entry.Issues)
+
+				// Busy labels overflow the first issue page; keep counting
+				// page by page so the reported totals stay exact
+				if pageInfoData, ok := issuesData["pageInfo"].(map[string]interface{}); ok && pageInfoData != nil {
+					if hasNextPage, _ := pageInfoData["hasNextPage"].(bool); hasNextPage {
+						more, err := c.countLabelIssues(entry.ID, getStringValue(pageInfoData, "endCursor"))
+						if err != nil {
+							return nil, err
+						}
+						entry.IssueCount += more
+					}
+				}
+			}
+
+			result = append(result, entry)
+		}
+
+		// Follow pagination until exhausted
+		pageInfoData, ok := labelsData["pageInfo"].(map[string]interface{})
+		if !ok || pageInfoData == nil {
+			return result, nil
+		}
+
+		hasNextPage, _ := pageInfoData["hasNextPage"].(bool)
+		if !hasNextPage {
+			return result, nil
+		}
+
+		after = getStringValue(pageInfoData, "endCursor")
+		if after == "" {
+			return result, nil
+		}
+	}
+}
+
+// GetTeamWorkload counts the non-completed issues of a team per assignee,
+// following pagination so large backlogs are counted in full.
+// Unassigned issues are skipped; the result is sorted by count (highest first),
+// then by name.
+func (c *LinearClient) GetTeamWorkload(teamID string) ([]AssigneeWorkload, error) {
+	query := `
+		query GetTeamWorkload($teamId: String!, $after: String) {
+			team(id: $teamId) {
+				issues(first: 250, after: $after, filter: { state: { type: { nin: ["completed", "canceled"] } } }) {
+					nodes {
+						id
+						assignee {
+							id
+							name
+							email
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	// Count the issues per assignee
+	counts := map[string]*AssigneeWorkload{}
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"teamId": teamID,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		resp, err := c.executeGraphQL(query, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		// Extract the team from the response
+		teamData, ok := resp.Data["team"].(map[string]interface{})
+		if !ok || teamData == nil {
+			return nil, fmt.Errorf("team %s not found", teamID)
+		}
+
+		issuesData, ok := teamData["issues"].(map[string]interface{})
+		if !ok || issuesData == nil {
+			break
+		}
+
+		nodesData, ok := issuesData["nodes"].([]interface{})
+		if !ok || nodesData == nil {
+			break
+		}
+
+		for _, nodeData := range nodesData {
+			issueData, ok := nodeData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			assigneeData, ok := issueData["assignee"].(map[string]interface{})
+			if !ok || assigneeData == nil {
+				continue
+			}
+
+			id := getStringValue(assigneeData, "id")
+			entry, ok := counts[id]
+			if !ok {
+				entry = &AssigneeWorkload{
+					User: User{
+						ID:    id,
+						Name:  getStringValue(assigneeData, "name"),
+						Email: getStringValue(assigneeData, "email"),
+					},
+				}
+				counts[id] = entry
+			}
+			entry.IssueCount++
+		}
+
+		// Follow pagination until exhausted
+		pageInfoData, ok := issuesData["pageInfo"].(map[string]interface{})
+		if !ok || pageInfoData == nil {
+			break
+		}
+
+		hasNextPage, _ := pageInfoData["hasNextPage"].(bool)
+		if !hasNextPage {
+			break
+		}
+
+		after = getStringValue(pageInfoData, "endCursor")
+		if after == "" {
+			break
+		}
+	}
+
+	workload := make([]AssigneeWorkload, 0, len(counts))
+	for _, entry := range counts {
+		workload = append(workload, *entry)
+	}
+
+	// Sort by count (highest first), then by name for stable output
+	sort.Slice(workload, func(i, j int) bool {
+		if workload[i].IssueCount != workload[j].IssueCount {
+			return workload[i].IssueCount > workload[j].IssueCount
+		}
+		return workload[i].Name < workload[j].Name
+	})
+
+	return workload, nil
+}
+
+// GetMyWork gets the viewer's assigned and subscribed issues, de-duplicated
+// and sorted by last update (most recent first).
+func (c *LinearClient) GetMyWork(limit int) ([]Issue, error) {
+	query := `
+		query GetMyWork($first: Int) {
+			viewer {
+				assignedIssues(first: $first) {
+					nodes {
+						id
+						identifier
+						title
+						priority
+						url
+						updatedAt
+						state {
+							id
+							name
+						}
+					}
+				}
+			}
+			issues(filter: { subscribers: { some: { isMe: { eq: true } } } }, first: $first) {
+				nodes {
+					id
+					identifier
+					title
+					priority
+					url
+					updatedAt
+					state {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	// Set default limit if not provided
+	if limit <= 0 {
+		limit = 50
+	}
+
+	variables := map[string]interface{}{
+		"first": limit,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the issues from both lists, de-duplicating by ID
+	issues := []Issue{}
+	seen := map[string]bool{}
+	appendNodes := func(container map[string]interface{}) error {
+		nodesData, ok := container["nodes"].([]interface{})
+		if !ok || nodesData == nil {
+			return nil
+		}
+		for _, nodeData := range nodesData {
+			issueData, ok := nodeData.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			var issue Issue
+			issueBytes, err := json.Marshal(issueData)
+			if err != nil {
+				return fmt.Errorf("failed to marshal issue data: %w", err)
+			}
+			if err := json.Unmarshal(issueBytes, &issue); err != nil {
+				return fmt.Errorf("failed to unmarshal issue data: %w", err)
+			}
+
+			if seen[issue.ID] {
+				continue
+			}
+			seen[issue.ID] = true
+			issues = append(issues, issue)
+		}
+		return nil
+	}
+
+	if viewerData, ok := resp.Data["viewer"].(map[string]interface{}); ok && viewerData != nil {
+		if assignedData, ok := viewerData["assignedIssues"].(map[string]interface{}); ok && assignedData != nil {
+			if err := appendNodes(assignedData); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if issuesData, ok := resp.Data["issues"].(map[string]interface{}); ok && issuesData != nil {
+		if err := appendNodes(issuesData); err != nil {
+			return nil, err
+		}
+	}
+
+	// Sort by last update, most recent first
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].UpdatedAt.After(issues[j].UpdatedAt)
+	})
+
+	if len(issues) > limit {
+		issues = issues[:limit]
+	}
+
+	return issues, nil
+}
+
+// GetIssuesUpdatedSince gets issues that were updated after the given instant.
+func (c *LinearClient) GetIssuesUpdatedSince(since time.Time, limit int) ([]Issue, error) {
+	query := `
+		query GetIssuesUpdatedSince($filter: IssueFilter, $first: Int) {
+			issues(filter: $filter, first: $first) {
+				nodes {
+					id
+					identifier
+					title
+					url
+					updatedAt
+				}
+			}
+		}
+	`
+
+	// Set default limit if not provided
+	if limit <= 0 {
+		limit = 50
+	}
+
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"updatedAt": map[string]interface{}{"gt": since.Format(time.RFC3339)},
+		},
+		"first": limit,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the issues from the response
+	issuesData, ok := resp.Data["issues"].(map[string]interface{})
+	if !ok || issuesData == nil {
+		return []Issue{}, nil
+	}
+
+	nodesData, ok := issuesData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []Issue{}, nil
+	}
+
+	// Parse the issues data
+	issues := make([]Issue, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		issueData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var issue Issue
+		issueBytes, err := json.Marshal(issueData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+		}
+		if err := json.Unmarshal(issueBytes, &issue); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+// CreateIssue creates a new issue
+func (c *LinearClient) CreateIssue(ctx context.Context, input CreateIssueInput) (*Issue, error) {
+	query := `
+		mutation CreateIssue($input: IssueCreateInput!) {
+			issueCreate(input: $input) {
+				success
+				issue {
+					id
+					identifier
+					title
+					description
+					priority
+					url
+					createdAt
+					updatedAt
+					state {
+						id
+						name
+					}
+					assignee {
+						id
+						name
+						email
+					}
+					team {
+						id
+						name
+						key
+					}
+					labels {
+						nodes {
+							id
+							name
+							color
+						}
+					}
+					project {
+						id
+						name
+					}
+					projectMilestone {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	// Prepare variables
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"title":       input.Title,
+			"teamId":      input.TeamID,
+			"description": c.applyContentPrefix(input.Description),
+		},
+	}
+
+	if input.Priority != nil {
+		variables["input"].(map[string]interface{})["priority"] = *input.Priority
+	}
+
+	if input.Status != "" {
+		variables["input"].(map[string]interface{})["stateId"] = input.Status
+	}
+
+	if input.ParentID != nil && *input.ParentID != "" {
+		variables["input"].(map[string]interface{})["parentId"] = *input.ParentID
+	}
+
+	if len(input.LabelIDs) > 0 {
+		variables["input"].(map[string]interface{})["labelIds"] = input.LabelIDs
+	}
+
+	if input.DueDate != "" {
+		variables["input"].(map[string]interface{})["dueDate"] = input.DueDate
+	}
+
+	if input.CycleID != "" {
+		variables["input"].(map[string]interface{})["cycleId"] = input.CycleID
+	}
+
+	if input.AssigneeID != "" {
+		variables["input"].(map[string]interface{})["assigneeId"] = input.AssigneeID
+	}
+
+	if input.ProjectID != "" {
+		variables["input"].(map[string]interface{})["projectId"] = input.ProjectID
+	}
+
+	if input.MilestoneID != "" {
+		variables["input"].(map[string]interface{})["milestoneId"] = input.MilestoneID
+	}
+
+	resp, err := c.executeGraphQLWithContext(ctx, query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the issue from the response
+	issueCreateData, ok := resp.Data["issueCreate"].(map[string]interface{})
+	if !ok || issueCreateData == nil {
+		return nil, errors.New("failed to create issue")
+	}
+
+	success, ok := issueCreateData["success"].(bool)
+	if !ok || !success {
+		return nil, errors.New("failed to create issue")
+	}
+
+	issueData, ok := issueCreateData["issue"].(map[string]interface{})
+	if !ok || issueData == nil {
+		return nil, errors.New("failed to create issue")
+	}
+
+	// Parse the issue data
+	var issue Issue
+	issueBytes, err := json.Marshal(issueData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+	}
+
+	if err := json.Unmarshal(issueBytes, &issue); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+	}
+
+	return &issue, nil
+}
+
+// UpdateIssue updates an existing issue
+func (c *LinearClient) UpdateIssue(input UpdateIssueInput) (*Issue, error) {
+	query := `
+		mutation UpdateIssue($id: String!, $input: IssueUpdateInput!) {
+			issueUpdate(id: $id, input: $input) {
+				success
+				issue {
+					id
+					identifier
+					title
+					description
+					priority
+					url
+					createdAt
+					updatedAt
+					state {
+						id
+						name
+					}
+					team {
+						id
+						name
+						key
+					}
+				}
+			}
+		}
+	`
+
+	// Prepare variables
+	updateInput := map[string]interface{}{}
+
+	if input.Title != "" {
+		updateInput["title"] = input.Title
+	}
+
+	if input.Description != "" {
+		updateInput["description"] = input.Description
+	}
+
+	if input.Priority != nil {
+		updateInput["priority"] = *input.Priority
+	}
+
+	if input.Status != "" {
+		updateInput["stateId"] = input.Status
+	}
+
+	if input.Status != "" {
+		updateInput["teamId"] = input.TeamID
+	}
+
+	if input.ProjectID != "" {
+		updateInput["projectId"] = input.ProjectID
+	}
+
+	if input.MilestoneID != "" {
+		updateInput["milestoneId"] = input.MilestoneID
+	}
+
+	if input.DueDate != "" {
+		updateInput["dueDate"] = input.DueDate
+	}
+
+	if input.CycleID != "" {
+		updateInput["cycleId"] = input.CycleID
+	}
+
+	if input.AssigneeID != nil {
+		if *input.AssigneeID == "" {
+			updateInput["assigneeId"] = nil
+		} else {
+			updateInput["assigneeId"] = *input.AssigneeID
+		}
+	}
+
+	if input.SortOrder != nil {
+		updateInput["sortOrder"] = *input.SortOrder
+	}
+
+	if input.Estimate != nil {
+		if *input.Estimate < 0 {
+			// Negative is the clear sentinel and sends null
+			updateInput["estimate"] = nil
+		} else {
+			updateInput["estimate"] = *input.Estimate
+		}
+	}
+
+	variables := map[string]interface{}{
+		"id":    input.ID,
+		"input": updateInput,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the issue from the response
+	issueUpdateData, ok := resp.Data["issueUpdate"].(map[string]interface{})
+	if !ok || issueUpdateData == nil {
+		return nil, errors.New("failed to update issue")
+	}
+
+	success, ok := issueUpdateData["success"].(bool)
+	if !ok || !success {
+		return nil, errors.New("failed to update issue")
+	}
+
+	issueData, ok := issueUpdateData["issue"].(map[string]interface{})
+	if !ok || issueData == nil {
+		return nil, errors.New("failed to update issue")
+	}
+
+	// Parse the issue data
+	var issue Issue
+	issueBytes, err := json.Marshal(issueData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+	}
+
+	if err := json.Unmarshal(issueBytes, &issue); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+	}
+
+	return &issue, nil
+}
+
+// DeleteIssue deletes an issue, moving it to the trash. This is distinct from
+// archiving: trashed issues are scheduled for permanent deletion by Linear.
+func (c *LinearClient) DeleteIssue(issueID string) error {
+	query := `
+		mutation DeleteIssue($id: String!) {
+			issueDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": issueID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return err
+	}
+
+	// Extract the result from the response
+	issueDeleteData, ok := resp.Data["issueDelete"].(map[string]interface{})
+	if !ok || issueDeleteData == nil {
+		return errors.New("failed to delete issue")
+	}
+
+	success, ok := issueDeleteData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to delete issue")
+	}
+
+	return nil
+}
+
+// GetCompletedIssuesSince gets a team's issues that were completed after the given instant
+func (c *LinearClient) GetCompletedIssuesSince(teamID string, since time.Time, limit int) ([]Issue, error) {
+	query := `
+		query GetCompletedIssuesSince($filter: IssueFilter, $first: Int) {
+			issues(filter: $filter, first: $first) {
+				nodes {
+					id
+					identifier
+					title
+					url
+					completedAt
+				}
+			}
+		}
+	`
+
+	// Set default limit if not provided
+	if limit <= 0 {
+		limit = 50
+	}
+
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"team":        map[string]interface{}{"id": map[string]interface{}{"eq": teamID}},
+			"state":       map[string]interface{}{"type": map[string]interface{}{"eq": "completed"}},
+			"completedAt": map[string]interface{}{"gte": since.Format(time.RFC3339)},
+		},
+		"first": limit,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the issues from the response
+	issuesData, ok := resp.Data["issues"].(map[string]interface{})
+	if !ok || issuesData == nil {
+		return []Issue{}, nil
+	}
+
+	nodesData, ok := issuesData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []Issue{}, nil
+	}
+
+	// Parse the issues data
+	issues := make([]Issue, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		issueData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var issue Issue
+		issueBytes, err := json.Marshal(issueData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+		}
+		if err := json.Unmarshal(issueBytes, &issue); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+// GetIssuesCreatedBetween gets a team's issues created within the given window
+func (c *LinearClient) GetIssuesCreatedBetween(teamID string, start, end time.Time, limit int) ([]Issue, error) {
+	query := `
+		query GetIssuesCreatedBetween($filter: IssueFilter, $first: Int) {
+			issues(filter: $filter, first: $first) {
+				nodes {
+					id
+					identifier
+					title
+					url
+					createdAt
+					state {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	// Set default limit if not provided
+	if limit <= 0 {
+		limit = 50
+	}
+
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"team": map[string]interface{}{"id": map[string]interface{}{"eq": teamID}},
+			"createdAt": map[string]interface{}{
+				"gte": start.Format(time.RFC3339),
+				"lte": end.Format(time.RFC3339),
+			},
+		},
+		"first": limit,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the issues from the response
+	issuesData, ok := resp.Data["issues"].(map[string]interface{})
+	if !ok || issuesData == nil {
+		return []Issue{}, nil
+	}
+
+	nodesData, ok := issuesData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []Issue{}, nil
+	}
+
+	// Parse the issues data
+	issues := make([]Issue, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		issueData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var issue Issue
+		issueBytes, err := json.Marshal(issueData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+		}
+		if err := json.Unmarshal(issueBytes, &issue); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+// GetStaleIssues gets a team's non-completed issues whose updatedAt is older
+// than the given instant, sorted oldest-first
+func (c *LinearClient) GetStaleIssues(teamID string, updatedBefore time.Time, limit int) ([]Issue, error) {
+	query := `
+		query GetStaleIssues($filter: IssueFilter, $first: Int) {
+			issues(filter: $filter, first: $first) {
+				nodes {
+					id
+					identifier
+					title
+					url
+					updatedAt
+				}
+			}
+		}
+	`
+
+	// Set default limit if not provided
+	if limit <= 0 {
+		limit = 50
+	}
+
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"team":      map[string]interface{}{"id": map[string]interface{}{"eq": teamID}},
+			"state":     map[string]interface{}{"type": map[string]interface{}{"nin": []string{"completed", "canceled"}}},
+			"updatedAt": map[string]interface{}{"lt": updatedBefore.Format(time.RFC3339)},
+		},
+		"first": limit,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the issues from the response
+	issuesData, ok := resp.Data["issues"].(map[string]interface{})
+	if !ok || issuesData == nil {
+		return []Issue{}, nil
+	}
+
+	nodesData, ok := issuesData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []Issue{}, nil
+	}
+
+	// Parse the issues data
+	issues := make([]Issue, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		issueData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var issue Issue
+		issueBytes, err := json.Marshal(issueData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+		}
+		if err := json.Unmarshal(issueBytes, &issue); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+		}
+
+		issues = append(issues, issue)
+	}
+
+	// Oldest first, so the most rotten issues lead the report
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].UpdatedAt.Before(issues[j].UpdatedAt)
+	})
+
+	return issues, nil
+}
+
+// AddIssueLabel adds a single label to an issue, keeping its existing labels
+func (c *LinearClient) AddIssueLabel(issueID, labelID string) error {
+	query := `
+		mutation IssueAddLabel($issueId: String!, $labelId: String!) {
+			issueAddLabel(id: $issueId, labelId: $labelId) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"issueId": issueID,
+		"labelId": labelID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return err
 	}
 
-	if err := json.Unmarshal(issueBytes, &issue); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+	// Extract the result from the response
+	issueAddLabelData, ok := resp.Data["issueAddLabel"].(map[string]interface{})
+	if !ok || issueAddLabelData == nil {
+		return errors.New("failed to add label to issue")
 	}
 
-	return &issue, nil
+	success, ok := issueAddLabelData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to add label to issue")
+	}
+
+	return nil
 }
 
-// GetLabelsByName gets labels by name for a team
-func (c *LinearClient) GetLabelsByName(teamID string, labelNames []string) ([]Label, error) {
+// CreateIssueRelation creates a relation between two issues and returns the created relation
+func (c *LinearClient) CreateIssueRelation(issueID, relatedIssueID, relationType string) (*IssueRelation, error) {
 	query := `
-		query GetLabelsByName($teamId: String!, $names: [String!]!) {
-			team(id: $teamId) {
-				labels(filter: { name: { in: $names } }) {
-					nodes {
+		mutation IssueRelationCreate($input: IssueRelationCreateInput!) {
+			issueRelationCreate(input: $input) {
+				success
+				issueRelation {
+					id
+					type
+					issue {
 						id
-						name
+						identifier
+						title
+					}
+					relatedIssue {
+						id
+						identifier
+						title
 					}
 				}
 			}
@@ -1206,8 +3767,11 @@ func (c *LinearClient) GetLabelsByName(teamID string, labelNames []string) ([]La
 	`
 
 	variables := map[string]interface{}{
-		"teamId": teamID,
-		"names":  labelNames,
+		"input": map[string]interface{}{
+			"issueId":        issueID,
+			"relatedIssueId": relatedIssueID,
+			"type":           relationType,
+		},
 	}
 
 	resp, err := c.executeGraphQL(query, variables)
@@ -1215,108 +3779,62 @@ func (c *LinearClient) GetLabelsByName(teamID string, labelNames []string) ([]La
 		return nil, err
 	}
 
-	// Extract the team from the response
-	teamData, ok := resp.Data["team"].(map[string]interface{})
-	if !ok || teamData == nil {
-		return nil, fmt.Errorf("team %s not found", teamID)
+	// Extract the result from the response
+	relationCreateData, ok := resp.Data["issueRelationCreate"].(map[string]interface{})
+	if !ok || relationCreateData == nil {
+		return nil, errors.New("failed to create issue relation")
 	}
 
-	// Extract the labels
-	labelsData, ok := teamData["labels"].(map[string]interface{})
-	if !ok || labelsData == nil {
-		return []Label{}, nil
+	success, ok := relationCreateData["success"].(bool)
+	if !ok || !success {
+		return nil, errors.New("failed to create issue relation")
 	}
 
-	nodesData, ok := labelsData["nodes"].([]interface{})
-	if !ok || nodesData == nil {
-		return []Label{}, nil
+	relationData, ok := relationCreateData["issueRelation"].(map[string]interface{})
+	if !ok || relationData == nil {
+		return nil, errors.New("failed to create issue relation")
 	}
 
-	// Parse the labels data
-	labels := make([]Label, 0, len(nodesData))
-	for _, nodeData := range nodesData {
-		labelData, ok := nodeData.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		label := Label{
-			ID:   getStringValue(labelData, "id"),
-			Name: getStringValue(labelData, "name"),
-		}
+	relationJSON, err := json.Marshal(relationData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal relation data: %v", err)
+	}
 
-		labels = append(labels, label)
+	var relation IssueRelation
+	if err := json.Unmarshal(relationJSON, &relation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal relation data: %v", err)
 	}
 
-	return labels, nil
+	return &relation, nil
 }
 
-// CreateIssue creates a new issue
-func (c *LinearClient) CreateIssue(input CreateIssueInput) (*Issue, error) {
+// CreateAttachment attaches an external URL to an issue and returns the created attachment
+func (c *LinearClient) CreateAttachment(issueID, title, url, subtitle string) (*Attachment, error) {
 	query := `
-		mutation CreateIssue($input: IssueCreateInput!) {
-			issueCreate(input: $input) {
+		mutation AttachmentCreate($input: AttachmentCreateInput!) {
+			attachmentCreate(input: $input) {
 				success
-				issue {
+				attachment {
 					id
-					identifier
 					title
-					description
-					priority
+					subtitle
 					url
-					createdAt
-					updatedAt
-					state {
-						id
-						name
-					}
-					team {
-						id
-						name
-						key
-					}
-					labels {
-						nodes {
-							id
-							name
-						}
-					}
-					project {
-						id
-						name
-					}
-					projectMilestone {
-						id
-						name
-					}
 				}
 			}
 		}
 	`
 
-	// Prepare variables
-	variables := map[string]interface{}{
-		"input": map[string]interface{}{
-			"title":       input.Title,
-			"teamId":      input.TeamID,
-			"description": input.Description,
-		},
-	}
-
-	if input.Priority != nil {
-		variables["input"].(map[string]interface{})["priority"] = *input.Priority
-	}
-
-	if input.Status != "" {
-		variables["input"].(map[string]interface{})["stateId"] = input.Status
+	attachmentInput := map[string]interface{}{
+		"issueId": issueID,
+		"title":   title,
+		"url":     url,
 	}
-
-	if input.ParentID != nil && *input.ParentID != "" {
-		variables["input"].(map[string]interface{})["parentId"] = *input.ParentID
+	if subtitle != "" {
+		attachmentInput["subtitle"] = subtitle
 	}
 
-	if len(input.LabelIDs) > 0 {
-		variables["input"].(map[string]interface{})["labelIds"] = input.LabelIDs
+	variables := map[string]interface{}{
+		"input": attachmentInput,
 	}
 
 	resp, err := c.executeGraphQL(query, variables)
@@ -1324,141 +3842,167 @@ func (c *LinearClient) CreateIssue(input CreateIssueInput) (*Issue, error) {
 		return nil, err
 	}
 
-	// Extract the issue from the response
-	issueCreateData, ok := resp.Data["issueCreate"].(map[string]interface{})
-	if !ok || issueCreateData == nil {
-		return nil, errors.New("failed to create issue")
+	// Extract the result from the response
+	attachmentCreateData, ok := resp.Data["attachmentCreate"].(map[string]interface{})
+	if !ok || attachmentCreateData == nil {
+		return nil, errors.New("failed to create attachment")
 	}
 
-	success, ok := issueCreateData["success"].(bool)
+	success, ok := attachmentCreateData["success"].(bool)
 	if !ok || !success {
-		return nil, errors.New("failed to create issue")
+		return nil, errors.New("failed to create attachment")
 	}
 
-	issueData, ok := issueCreateData["issue"].(map[string]interface{})
-	if !ok || issueData == nil {
-		return nil, errors.New("failed to create issue")
-	}
-
-	// Parse the issue data
-	var issue Issue
-	issueBytes, err := json.Marshal(issueData)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal issue data: %w", err)
-	}
-
-	if err := json.Unmarshal(issueBytes, &issue); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+	attachmentData, ok := attachmentCreateData["attachment"].(map[string]interface{})
+	if !ok || attachmentData == nil {
+		return nil, errors.New("failed to create attachment")
 	}
 
-	return &issue, nil
+	return &Attachment{
+		ID:       getStringValue(attachmentData, "id"),
+		Title:    getStringValue(attachmentData, "title"),
+		Subtitle: getStringValue(attachmentData, "subtitle"),
+		URL:      getStringValue(attachmentData, "url"),
+	}, nil
 }
 
-// UpdateIssue updates an existing issue
-func (c *LinearClient) UpdateIssue(input UpdateIssueInput) (*Issue, error) {
+// DeleteAttachment removes an attachment from an issue
+func (c *LinearClient) DeleteAttachment(attachmentID string) error {
 	query := `
-		mutation UpdateIssue($id: String!, $input: IssueUpdateInput!) {
-			issueUpdate(id: $id, input: $input) {
+		mutation AttachmentDelete($id: String!) {
+			attachmentDelete(id: $id) {
 				success
-				issue {
-					id
-					identifier
-					title
-					description
-					priority
-					url
-					createdAt
-					updatedAt
-					state {
-						id
-						name
-					}
-					team {
-						id
-						name
-						key
-					}
-				}
 			}
 		}
 	`
 
-	// Prepare variables
-	updateInput := map[string]interface{}{}
+	variables := map[string]interface{}{
+		"id": attachmentID,
+	}
 
-	if input.Title != "" {
-		updateInput["title"] = input.Title
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return err
 	}
 
-	if input.Description != "" {
-		updateInput["description"] = input.Description
+	// Extract the result from the response
+	attachmentDeleteData, ok := resp.Data["attachmentDelete"].(map[string]interface{})
+	if !ok || attachmentDeleteData == nil {
+		return errors.New("failed to delete attachment")
 	}
 
-	if input.Priority != nil {
-		updateInput["priority"] = *input.Priority
+	success, ok := attachmentDeleteData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to delete attachment")
 	}
 
-	if input.Status != "" {
-		updateInput["stateId"] = input.Status
+	return nil
+}
+
+// DeleteIssueRelation deletes a relation between two issues
+func (c *LinearClient) DeleteIssueRelation(relationID string) error {
+	query := `
+		mutation IssueRelationDelete($id: String!) {
+			issueRelationDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": relationID,
 	}
 
-	if input.Status != "" {
-		updateInput["teamId"] = input.TeamID
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return err
 	}
 
-	if input.ProjectID != "" {
-		updateInput["projectId"] = input.ProjectID
+	// Extract the result from the response
+	relationDeleteData, ok := resp.Data["issueRelationDelete"].(map[string]interface{})
+	if !ok || relationDeleteData == nil {
+		return errors.New("failed to delete issue relation")
 	}
 
-	if input.MilestoneID != "" {
-		updateInput["milestoneId"] = input.MilestoneID
+	success, ok := relationDeleteData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to delete issue relation")
 	}
 
+	return nil
+}
+
+// ArchiveIssue archives an issue without deleting it
+func (c *LinearClient) ArchiveIssue(issueID string) error {
+	query := `
+		mutation ArchiveIssue($id: String!) {
+			issueArchive(id: $id) {
+				success
+			}
+		}
+	`
+
 	variables := map[string]interface{}{
-		"id":    input.ID,
-		"input": updateInput,
+		"id": issueID,
 	}
 
 	resp, err := c.executeGraphQL(query, variables)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Extract the issue from the response
-	issueUpdateData, ok := resp.Data["issueUpdate"].(map[string]interface{})
-	if !ok || issueUpdateData == nil {
-		return nil, errors.New("failed to update issue")
+	// Extract the result from the response
+	issueArchiveData, ok := resp.Data["issueArchive"].(map[string]interface{})
+	if !ok || issueArchiveData == nil {
+		return errors.New("failed to archive issue")
 	}
 
-	success, ok := issueUpdateData["success"].(bool)
+	success, ok := issueArchiveData["success"].(bool)
 	if !ok || !success {
-		return nil, errors.New("failed to update issue")
+		return errors.New("failed to archive issue")
 	}
 
-	issueData, ok := issueUpdateData["issue"].(map[string]interface{})
-	if !ok || issueData == nil {
-		return nil, errors.New("failed to update issue")
+	return nil
+}
+
+// UnarchiveIssue restores a previously archived issue
+func (c *LinearClient) UnarchiveIssue(issueID string) error {
+	query := `
+		mutation UnarchiveIssue($id: String!) {
+			issueUnarchive(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": issueID,
 	}
 
-	// Parse the issue data
-	var issue Issue
-	issueBytes, err := json.Marshal(issueData)
+	resp, err := c.executeGraphQL(query, variables)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+		return err
 	}
 
-	if err := json.Unmarshal(issueBytes, &issue); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal issue data: %w", err)
+	// Extract the result from the response
+	issueUnarchiveData, ok := resp.Data["issueUnarchive"].(map[string]interface{})
+	if !ok || issueUnarchiveData == nil {
+		return errors.New("failed to unarchive issue")
 	}
 
-	return &issue, nil
+	success, ok := issueUnarchiveData["success"].(bool)
+	if !ok || !success {
+		return errors.New("failed to unarchive issue")
+	}
+
+	return nil
 }
 
 // SearchIssues searches for issues with filters
-func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueResponse, error) {
+func (c *LinearClient) SearchIssues(ctx context.Context, input SearchIssuesInput) (*PaginatedIssueConnection, error) {
 	query := `
-		query SearchIssues($filter: IssueFilter, $first: Int, $includeArchived: Boolean) {
-			issues(filter: $filter, first: $first, includeArchived: $includeArchived) {
+		query SearchIssues($filter: IssueFilter, $first: Int, $after: String, $includeArchived: Boolean) {
+			issues(filter: $filter, first: $first, after: $after, includeArchived: $includeArchived) {
 				nodes {
 					id
 					identifier
@@ -1481,6 +4025,10 @@ func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueRespo
 						}
 					}
 				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
 			}
 		}
 	`
@@ -1502,8 +4050,28 @@ func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueRespo
 	}
 
 	if input.Status != "" {
+		// A comma-separated status matches any of the listed state names
+		statuses := []string{}
+		for _, status := range strings.Split(input.Status, ",") {
+			if trimmed := strings.TrimSpace(status); trimmed != "" {
+				statuses = append(statuses, trimmed)
+			}
+		}
+		if len(statuses) > 1 {
+			filter["state"] = map[string]interface{}{
+				"name": map[string]interface{}{"in": statuses},
+			}
+		} else if len(statuses) == 1 {
+			filter["state"] = map[string]interface{}{
+				"name": map[string]interface{}{"eq": statuses[0]},
+			}
+		}
+	}
+
+	if input.OpenOnly && input.Status == "" {
+		// An explicit status filter takes precedence over the open-only shortcut
 		filter["state"] = map[string]interface{}{
-			"name": map[string]interface{}{"eq": input.Status},
+			"type": map[string]interface{}{"nin": []string{"completed", "canceled"}},
 		}
 	}
 
@@ -1513,6 +4081,18 @@ func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueRespo
 		}
 	}
 
+	if input.ProjectID != "" {
+		filter["project"] = map[string]interface{}{
+			"id": map[string]interface{}{"eq": input.ProjectID},
+		}
+	}
+
+	if input.MilestoneID != "" {
+		filter["projectMilestone"] = map[string]interface{}{
+			"id": map[string]interface{}{"eq": input.MilestoneID},
+		}
+	}
+
 	if len(input.Labels) > 0 {
 		filter["labels"] = map[string]interface{}{
 			"some": map[string]interface{}{
@@ -1521,12 +4101,46 @@ func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueRespo
 		}
 	}
 
+	if input.Parent != "" {
+		if input.Parent == "none" {
+			filter["parent"] = map[string]interface{}{"null": true}
+		} else {
+			filter["parent"] = map[string]interface{}{
+				"id": map[string]interface{}{"eq": input.Parent},
+			}
+		}
+	}
+
 	if input.Priority != nil {
 		filter["priority"] = map[string]interface{}{"eq": *input.Priority}
 	}
-
-	if input.Estimate != nil {
-		filter["estimate"] = map[string]interface{}{"eq": *input.Estimate}
+
+	if input.Unestimated {
+		filter["estimate"] = map[string]interface{}{"null": true}
+	} else if input.Estimate != nil {
+		filter["estimate"] = map[string]interface{}{"eq": *input.Estimate}
+	}
+
+	createdAt := map[string]interface{}{}
+	if input.CreatedAfter != "" {
+		createdAt["gte"] = input.CreatedAfter
+	}
+	if input.CreatedBefore != "" {
+		createdAt["lte"] = input.CreatedBefore
+	}
+	if len(createdAt) > 0 {
+		filter["createdAt"] = createdAt
+	}
+
+	updatedAt := map[string]interface{}{}
+	if input.UpdatedAfter != "" {
+		updatedAt["gte"] = input.UpdatedAfter
+	}
+	if input.UpdatedBefore != "" {
+		updatedAt["lte"] = input.UpdatedBefore
+	}
+	if len(updatedAt) > 0 {
+		filter["updatedAt"] = updatedAt
 	}
 
 	// Set default limit if not provided
@@ -1541,7 +4155,11 @@ func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueRespo
 		"includeArchived": input.IncludeArchived,
 	}
 
-	resp, err := c.executeGraphQL(query, variables)
+	if input.AfterCursor != "" {
+		variables["after"] = input.AfterCursor
+	}
+
+	resp, err := c.executeGraphQLWithContext(ctx, query, variables)
 	if err != nil {
 		return nil, err
 	}
@@ -1549,12 +4167,21 @@ func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueRespo
 	// Extract the issues from the response
 	issuesData, ok := resp.Data["issues"].(map[string]interface{})
 	if !ok || issuesData == nil {
-		return []LinearIssueResponse{}, nil
+		return &PaginatedIssueConnection{Nodes: []LinearIssueResponse{}}, nil
+	}
+
+	// Extract the page info
+	pageInfo := PageInfo{}
+	if pageInfoData, ok := issuesData["pageInfo"].(map[string]interface{}); ok && pageInfoData != nil {
+		if hasNextPage, ok := pageInfoData["hasNextPage"].(bool); ok {
+			pageInfo.HasNextPage = hasNextPage
+		}
+		pageInfo.EndCursor = getStringValue(pageInfoData, "endCursor")
 	}
 
 	nodesData, ok := issuesData["nodes"].([]interface{})
 	if !ok || nodesData == nil {
-		return []LinearIssueResponse{}, nil
+		return &PaginatedIssueConnection{Nodes: []LinearIssueResponse{}, PageInfo: pageInfo}, nil
 	}
 
 	// Parse the issues data
@@ -1573,13 +4200,22 @@ func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueRespo
 			}
 		}
 
+		// Extract assignee name
+		var assigneeName string
+		if assigneeData, ok := issueData["assignee"].(map[string]interface{}); ok && assigneeData != nil {
+			if name, ok := assigneeData["name"].(string); ok {
+				assigneeName = name
+			}
+		}
+
 		// Create the issue response
 		issue := LinearIssueResponse{
-			ID:         getStringValue(issueData, "id"),
-			Identifier: getStringValue(issueData, "identifier"),
-			Title:      getStringValue(issueData, "title"),
-			URL:        getStringValue(issueData, "url"),
-			StateName:  stateName,
+			ID:           getStringValue(issueData, "id"),
+			Identifier:   getStringValue(issueData, "identifier"),
+			Title:        getStringValue(issueData, "title"),
+			URL:          getStringValue(issueData, "url"),
+			StateName:    stateName,
+			AssigneeName: assigneeName,
 		}
 
 		// Extract priority
@@ -1590,7 +4226,7 @@ func (c *LinearClient) SearchIssues(input SearchIssuesInput) ([]LinearIssueRespo
 		issues = append(issues, issue)
 	}
 
-	return issues, nil
+	return &PaginatedIssueConnection{Nodes: issues, PageInfo: pageInfo}, nil
 }
 
 // GetUserIssues gets issues assigned to a user
@@ -1700,7 +4336,7 @@ func (c *LinearClient) GetUserIssues(input GetUserIssuesInput) ([]LinearIssueRes
 }
 
 // AddComment adds a comment to an issue
-func (c *LinearClient) AddComment(input AddCommentInput) (*Comment, *Issue, error) {
+func (c *LinearClient) AddComment(ctx context.Context, input AddCommentInput) (*Comment, *Issue, error) {
 	query := `
 		mutation AddComment($input: CommentCreateInput!) {
 			commentCreate(input: $input) {
@@ -1710,6 +4346,7 @@ func (c *LinearClient) AddComment(input AddCommentInput) (*Comment, *Issue, erro
 					body
 					url
 					createdAt
+					editedAt
 					user {
 						id
 						name
@@ -1728,7 +4365,7 @@ func (c *LinearClient) AddComment(input AddCommentInput) (*Comment, *Issue, erro
 	// Prepare variables
 	commentInput := map[string]interface{}{
 		"issueId": input.IssueID,
-		"body":    input.Body,
+		"body":    c.applyContentPrefix(input.Body),
 	}
 
 	if input.CreateAsUser != "" {
@@ -1743,7 +4380,7 @@ func (c *LinearClient) AddComment(input AddCommentInput) (*Comment, *Issue, erro
 		"input": commentInput,
 	}
 
-	resp, err := c.executeGraphQL(query, variables)
+	resp, err := c.executeGraphQLWithContext(ctx, query, variables)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1805,6 +4442,7 @@ func (c *LinearClient) UpdateComment(input UpdateCommentInput) (*Comment, *Issue
 					body
 					url
 					createdAt
+					editedAt
 					user {
 						id
 						name
@@ -1890,6 +4528,7 @@ func (c *LinearClient) GetCommentByHash(hash string) (*Comment, error) {
 				body
 				url
 				createdAt
+				editedAt
 				user {
 					id
 					name
@@ -1927,6 +4566,83 @@ func (c *LinearClient) GetCommentByHash(hash string) (*Comment, error) {
 	return &comment, nil
 }
 
+// SearchComments searches comment bodies for the given text
+func (c *LinearClient) SearchComments(text string, limit int) ([]CommentMatch, error) {
+	query := `
+		query SearchComments($filter: CommentFilter, $first: Int) {
+			comments(filter: $filter, first: $first) {
+				nodes {
+					id
+					body
+					url
+					createdAt
+					user {
+						id
+						name
+					}
+					issue {
+						id
+						identifier
+						title
+						url
+						state {
+							id
+							name
+							type
+						}
+					}
+				}
+			}
+		}
+	`
+
+	// Set default limit if not provided
+	if limit <= 0 {
+		limit = 25
+	}
+
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"body": map[string]interface{}{"contains": text},
+		},
+		"first": limit,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the comments from the response
+	commentsData, ok := resp.Data["comments"].(map[string]interface{})
+	if !ok || commentsData == nil {
+		return []CommentMatch{}, nil
+	}
+
+	nodes, ok := commentsData["nodes"].([]interface{})
+	if !ok {
+		return []CommentMatch{}, nil
+	}
+
+	// Parse the comment data
+	matches := []CommentMatch{}
+	for _, node := range nodes {
+		matchBytes, err := json.Marshal(node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal comment data: %w", err)
+		}
+
+		var match CommentMatch
+		if err := json.Unmarshal(matchBytes, &match); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal comment data: %w", err)
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
+
 // GetTeamIssues gets issues for a team
 func (c *LinearClient) GetTeamIssues(teamID string) ([]LinearIssueResponse, error) {
 	query := `
@@ -2093,6 +4809,74 @@ func (c *LinearClient) GetViewer() (*User, []Team, *Organization, error) {
 	return &user, teams, &org, nil
 }
 
+// GetDrafts gets the viewer's draft issues
+func (c *LinearClient) GetDrafts(limit int) ([]IssueDraft, error) {
+	query := `
+		query GetDrafts($first: Int!) {
+			issueDrafts(first: $first) {
+				nodes {
+					id
+					title
+					description
+					createdAt
+					updatedAt
+					team {
+						id
+						name
+						key
+					}
+				}
+			}
+		}
+	`
+
+	// Set default limit if not provided
+	if limit <= 0 {
+		limit = 25
+	}
+
+	variables := map[string]interface{}{
+		"first": limit,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	draftsData, ok := resp.Data["issueDrafts"].(map[string]interface{})
+	if !ok || draftsData == nil {
+		return []IssueDraft{}, nil
+	}
+
+	nodesData, ok := draftsData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []IssueDraft{}, nil
+	}
+
+	// Parse the drafts data
+	drafts := make([]IssueDraft, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		draftData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var draft IssueDraft
+		draftBytes, err := json.Marshal(draftData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal draft data: %w", err)
+		}
+		if err := json.Unmarshal(draftBytes, &draft); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal draft data: %w", err)
+		}
+
+		drafts = append(drafts, draft)
+	}
+
+	return drafts, nil
+}
+
 // GetOrganization gets the organization
 func (c *LinearClient) GetOrganization() (*Organization, error) {
 	query := `
@@ -2112,6 +4896,7 @@ func (c *LinearClient) GetOrganization() (*Organization, error) {
 					nodes {
 						id
 						name
+						displayName
 						email
 						admin
 						active
@@ -2169,15 +4954,20 @@ func (c *LinearClient) GetOrganization() (*Organization, error) {
 				}
 
 				user := User{
-					ID:    getStringValue(userData, "id"),
-					Name:  getStringValue(userData, "name"),
-					Email: getStringValue(userData, "email"),
+					ID:          getStringValue(userData, "id"),
+					Name:        getStringValue(userData, "name"),
+					DisplayName: getStringValue(userData, "displayName"),
+					Email:       getStringValue(userData, "email"),
 				}
 
 				if admin, ok := userData["admin"].(bool); ok {
 					user.Admin = admin
 				}
 
+				if active, ok := userData["active"].(bool); ok {
+					user.Active = active
+				}
+
 				org.Users = append(org.Users, user)
 			}
 		}
@@ -2350,9 +5140,27 @@ func (c *LinearClient) GetTeams(name string) ([]Team, error) {
 		}
 
 		team := Team{
-			ID:   getStringValue(teamData, "id"),
-			Name: getStringValue(teamData, "name"),
-			Key:  getStringValue(teamData, "key"),
+			ID:          getStringValue(teamData, "id"),
+			Name:        getStringValue(teamData, "name"),
+			Key:         getStringValue(teamData, "key"),
+			Description: getStringValue(teamData, "description"),
+		}
+
+		// Parse the workflow states the query fetches alongside the team
+		if statesData, ok := teamData["states"].(map[string]interface{}); ok && statesData != nil {
+			if stateNodes, ok := statesData["nodes"].([]interface{}); ok {
+				for _, stateNode := range stateNodes {
+					stateData, ok := stateNode.(map[string]interface{})
+					if !ok {
+						continue
+					}
+
+					team.States = append(team.States, State{
+						ID:   getStringValue(stateData, "id"),
+						Name: getStringValue(stateData, "name"),
+					})
+				}
+			}
 		}
 
 		teams = append(teams, team)
@@ -2361,6 +5169,200 @@ func (c *LinearClient) GetTeams(name string) ([]Team, error) {
 	return teams, nil
 }
 
+// GetWorkflowStates gets the workflow states of a team, ordered by position
+func (c *LinearClient) GetWorkflowStates(teamID string) ([]State, error) {
+	query := `
+		query GetWorkflowStates($teamId: String!) {
+			team(id: $teamId) {
+				states {
+					nodes {
+						id
+						name
+						type
+						position
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the team from the response
+	teamData, ok := resp.Data["team"].(map[string]interface{})
+	if !ok || teamData == nil {
+		return nil, fmt.Errorf("team %s not found", teamID)
+	}
+
+	// Extract the states
+	statesData, ok := teamData["states"].(map[string]interface{})
+	if !ok || statesData == nil {
+		return []State{}, nil
+	}
+
+	nodesData, ok := statesData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []State{}, nil
+	}
+
+	// Parse the states data
+	states := make([]State, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		stateData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var state State
+		stateBytes, err := json.Marshal(stateData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal state data: %w", err)
+		}
+
+		if err := json.Unmarshal(stateBytes, &state); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal state data: %w", err)
+		}
+
+		states = append(states, state)
+	}
+
+	// Order by position so output follows the board order
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Position < states[j].Position
+	})
+
+	return states, nil
+}
+
+// GetTeamCycles gets the cycles of a team, ordered by number
+func (c *LinearClient) GetTeamCycles(teamID string) ([]Cycle, error) {
+	query := `
+		query GetTeamCycles($teamId: String!) {
+			team(id: $teamId) {
+				cycles {
+					nodes {
+						id
+						number
+						name
+						startsAt
+						endsAt
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the team from the response
+	teamData, ok := resp.Data["team"].(map[string]interface{})
+	if !ok || teamData == nil {
+		return nil, fmt.Errorf("team %s not found", teamID)
+	}
+
+	// Extract the cycles
+	cyclesData, ok := teamData["cycles"].(map[string]interface{})
+	if !ok || cyclesData == nil {
+		return []Cycle{}, nil
+	}
+
+	nodesData, ok := cyclesData["nodes"].([]interface{})
+	if !ok || nodesData == nil {
+		return []Cycle{}, nil
+	}
+
+	// Parse the cycles data
+	cycles := make([]Cycle, 0, len(nodesData))
+	for _, nodeData := range nodesData {
+		cycleData, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var cycle Cycle
+		cycleBytes, err := json.Marshal(cycleData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal cycle data: %w", err)
+		}
+
+		if err := json.Unmarshal(cycleBytes, &cycle); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal cycle data: %w", err)
+		}
+
+		cycles = append(cycles, cycle)
+	}
+
+	// Order by number so output follows the cycle sequence
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i].Number < cycles[j].Number
+	})
+
+	return cycles, nil
+}
+
+// GetTeamActiveCycle gets the currently active cycle of a team. Returns nil
+// (without an error) if the team has no active cycle.
+func (c *LinearClient) GetTeamActiveCycle(teamID string) (*Cycle, error) {
+	query := `
+		query GetTeamActiveCycle($teamId: String!) {
+			team(id: $teamId) {
+				activeCycle {
+					id
+					number
+					name
+					startsAt
+					endsAt
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+
+	resp, err := c.executeGraphQL(query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	teamData, ok := resp.Data["team"].(map[string]interface{})
+	if !ok || teamData == nil {
+		return nil, fmt.Errorf("team %s not found", teamID)
+	}
+
+	cycleData, ok := teamData["activeCycle"].(map[string]interface{})
+	if !ok || cycleData == nil {
+		return nil, nil
+	}
+
+	var cycle Cycle
+	cycleBytes, err := json.Marshal(cycleData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cycle data: %w", err)
+	}
+
+	if err := json.Unmarshal(cycleBytes, &cycle); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cycle data: %w", err)
+	}
+
+	return &cycle, nil
+}
+
 // GetMetrics returns metrics about the API usage
 func (c *LinearClient) GetMetrics() APIMetrics {
 	metrics := c.rateLimiter.GetMetrics()
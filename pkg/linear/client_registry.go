@@ -0,0 +1,65 @@
+package linear
+
+import (
+	"sync"
+)
+
+// ClientRegistry hands out LinearClients keyed by API key. Each key gets its
+// own client — and therefore its own rate limiter — so one tenant exhausting
+// its Linear quota does not throttle the others. Clients are cached for the
+// lifetime of the registry.
+type ClientRegistry struct {
+	serverVersion string
+	maxRetries    int
+	contentPrefix string
+
+	mu      sync.Mutex
+	clients map[string]*LinearClient
+}
+
+// NewClientRegistry creates a registry that constructs clients with the given
+// server version.
+func NewClientRegistry(serverVersion string) *ClientRegistry {
+	return &ClientRegistry{
+		serverVersion: serverVersion,
+		maxRetries:    DefaultMaxRetries,
+		clients:       make(map[string]*LinearClient),
+	}
+}
+
+// SetMaxRetries configures the retry count applied to newly constructed
+// clients. Already cached clients keep their setting.
+func (r *ClientRegistry) SetMaxRetries(maxRetries int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.maxRetries = maxRetries
+}
+
+// SetContentPrefix configures the content prefix applied to newly constructed
+// clients. Already cached clients keep their setting.
+func (r *ClientRegistry) SetContentPrefix(prefix string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.contentPrefix = prefix
+}
+
+// ClientFor returns the client for the given API key, constructing and caching
+// it on first use.
+func (r *ClientRegistry) ClientFor(apiKey string) (*LinearClient, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if client, ok := r.clients[apiKey]; ok {
+		return client, nil
+	}
+
+	client, err := NewLinearClient(apiKey, r.serverVersion)
+	if err != nil {
+		return nil, err
+	}
+	client.SetMaxRetries(r.maxRetries)
+	client.SetContentPrefix(r.contentPrefix)
+
+	r.clients[apiKey] = client
+	return client, nil
+}
@@ -0,0 +1,85 @@
+package linear
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// captureTransport records the body of the last request and answers every
+// request with the same JSON body.
+type captureTransport struct {
+	lastRequestBody string
+	body            string
+}
+
+func (t *captureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	t.lastRequestBody = string(data)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+	}, nil
+}
+
+func newContentPrefixTestClient(body string) (*LinearClient, *captureTransport) {
+	transport := &captureTransport{body: body}
+	client := &LinearClient{
+		apiKey:        "test-api-key",
+		httpClient:    &http.Client{Transport: transport},
+		rateLimiter:   NewRateLimiter(1400, NewLoggerFromEnv()),
+		serverVersion: "test",
+	}
+	return client, transport
+}
+
+func TestApplyContentPrefix(t *testing.T) {
+	client, _ := newContentPrefixTestClient("")
+
+	// Off by default
+	if got := client.applyContentPrefix("hello"); got != "hello" {
+		t.Errorf("expected text to pass through unchanged, got %q", got)
+	}
+
+	client.SetContentPrefix("🤖 via linear-mcp")
+	if got := client.applyContentPrefix("hello"); got != "🤖 via linear-mcp\n\nhello" {
+		t.Errorf("expected prefixed text, got %q", got)
+	}
+
+	// Empty text stays empty so optional fields are not turned into prefix-only content
+	if got := client.applyContentPrefix(""); got != "" {
+		t.Errorf("expected empty text to stay empty, got %q", got)
+	}
+}
+
+func TestContentPrefixAppliedToComment(t *testing.T) {
+	client, transport := newContentPrefixTestClient(`{"data":{"commentCreate":{"success":true,"comment":{"id":"c1","body":"🤖 via linear-mcp\n\nhello","issue":{"id":"i1","identifier":"TEST-1"}}}}}`)
+	client.SetContentPrefix("🤖 via linear-mcp")
+
+	if _, _, err := client.AddComment(context.Background(), AddCommentInput{IssueID: "i1", Body: "hello"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(transport.lastRequestBody, `🤖 via linear-mcp\n\nhello`) {
+		t.Errorf("expected comment body to carry the content prefix, got request %s", transport.lastRequestBody)
+	}
+}
+
+func TestContentPrefixAppliedToIssueDescription(t *testing.T) {
+	client, transport := newContentPrefixTestClient(`{"data":{"issueCreate":{"success":true,"issue":{"id":"i1","identifier":"TEST-1","title":"New issue"}}}}`)
+	client.SetContentPrefix("🤖 via linear-mcp")
+
+	if _, err := client.CreateIssue(context.Background(), CreateIssueInput{TeamID: "t1", Title: "New issue", Description: "details"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(transport.lastRequestBody, `🤖 via linear-mcp\n\ndetails`) {
+		t.Errorf("expected issue description to carry the content prefix, got request %s", transport.lastRequestBody)
+	}
+}
@@ -0,0 +1,54 @@
+package linear
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// staticTransport answers every request with the same JSON body.
+type staticTransport struct {
+	body string
+}
+
+func (t *staticTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+	}, nil
+}
+
+func newIntegrationsTestClient(body string) *LinearClient {
+	return &LinearClient{
+		apiKey:        "test-api-key",
+		httpClient:    &http.Client{Transport: &staticTransport{body: body}},
+		rateLimiter:   NewRateLimiter(1400, NewLoggerFromEnv()),
+		serverVersion: "test",
+	}
+}
+
+func TestHasIntegrationMissing(t *testing.T) {
+	client := newIntegrationsTestClient(`{"data":{"integrations":{"nodes":[{"id":"i1","service":"slack"}]}}}`)
+
+	hasGitHub, err := client.HasIntegration("github")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hasGitHub {
+		t.Error("expected github integration to be reported as missing")
+	}
+}
+
+func TestHasIntegrationPresent(t *testing.T) {
+	client := newIntegrationsTestClient(`{"data":{"integrations":{"nodes":[{"id":"i1","service":"slack"},{"id":"i2","service":"GitHub"}]}}}`)
+
+	hasGitHub, err := client.HasIntegration("github")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !hasGitHub {
+		t.Error("expected github integration to be reported as present (case-insensitive)")
+	}
+}
@@ -0,0 +1,27 @@
+package linear
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// NewLoggerFromEnv builds a structured logger writing to stderr, so log output
+// never corrupts the MCP stdio framing on stdout. The level is read from the
+// LINEAR_LOG_LEVEL environment variable (debug/info/warn/error); it defaults
+// to warn, which keeps the per-request rate limiter lines silent.
+func NewLoggerFromEnv() *slog.Logger {
+	level := slog.LevelWarn
+	switch strings.ToLower(strings.TrimSpace(os.Getenv("LINEAR_LOG_LEVEL"))) {
+	case "debug":
+		level = slog.LevelDebug
+	case "info":
+		level = slog.LevelInfo
+	case "warn":
+		level = slog.LevelWarn
+	case "error":
+		level = slog.LevelError
+	}
+
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+}
@@ -24,34 +24,49 @@ type Issue struct {
 	Relations        *IssueRelationConnection `json:"relations,omitempty"`
 	InverseRelations *IssueRelationConnection `json:"inverseRelations,omitempty"`
 	Attachments      *AttachmentConnection    `json:"attachments,omitempty"`
+	CompletedAt      *time.Time               `json:"completedAt,omitempty"`
 }
 
 // User represents a Linear user
 type User struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
-	Admin bool   `json:"admin"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	Email       string `json:"email"`
+	Admin       bool   `json:"admin"`
+	Active      bool   `json:"active"`
+}
+
+// UserConnection represents a connection of users
+type UserConnection struct {
+	Nodes []User `json:"nodes"`
 }
 
 // Team represents a Linear team
 type Team struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Key  string `json:"key"`
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Key         string  `json:"key"`
+	Description string  `json:"description,omitempty"`
+	States      []State `json:"states,omitempty"`
+}
+
+// TeamConnection represents a connection of teams
+type TeamConnection struct {
+	Nodes []Team `json:"nodes"`
 }
 
 // Project represents a Linear project
 type Project struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	SlugID      string `json:"slugId"`
-	State       string `json:"state"`
-	Creator     *User  `json:"creator,omitempty"`
-	Lead        *User  `json:"lead,omitempty"`
-	// Members     *UserConnection `json:"members,omitempty"`
-	// Teams       *TeamConnection `json:"teams,omitempty"`
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	SlugID      string                `json:"slugId"`
+	State       string                `json:"state"`
+	Creator     *User                 `json:"creator,omitempty"`
+	Lead        *User                 `json:"lead,omitempty"`
+	Members     *UserConnection       `json:"members,omitempty"`
+	Teams       *TeamConnection       `json:"teams,omitempty"`
 	Initiatives *InitiativeConnection `json:"initiatives,omitempty"`
 	StartDate   *string               `json:"startDate,omitempty"`
 	TargetDate  *string               `json:"targetDate,omitempty"`
@@ -97,10 +112,21 @@ type Initiative struct {
 	URL         string `json:"url"`
 }
 
+// InitiativeUpdate represents an entry in an initiative's update feed.
+type InitiativeUpdate struct {
+	ID        string    `json:"id"`
+	Body      string    `json:"body"`
+	Health    string    `json:"health,omitempty"`
+	User      *User     `json:"user,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 // State represents a workflow state in Linear
 type State struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type,omitempty"`
+	Position float64 `json:"position,omitempty"`
 }
 
 // LabelConnection represents a connection of labels
@@ -110,8 +136,36 @@ type LabelConnection struct {
 
 // Label represents a Linear issue label
 type Label struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"` // hex color, e.g. "#a1b2c3"
+}
+
+// LabelUsage represents a label together with the number of issues using it
+type LabelUsage struct {
+	Label
+	IssueCount int `json:"issueCount"`
+}
+
+// LabelCreateInput represents the input for creating an issue label.
+type LabelCreateInput struct {
+	TeamID string `json:"teamId"`
+	Name   string `json:"name"`
+	Color  string `json:"color,omitempty"`
+}
+
+// LabelIssues represents a label together with the issues carrying it.
+// Issues holds the first page only; IssueCount is exact across all pages.
+type LabelIssues struct {
+	Label
+	Issues     []Issue `json:"issues"`
+	IssueCount int     `json:"issueCount"`
+}
+
+// AssigneeWorkload pairs a user with their count of non-completed issues
+type AssigneeWorkload struct {
+	User
+	IssueCount int `json:"issueCount"`
 }
 
 // CommentConnection represents a connection of comments
@@ -119,6 +173,16 @@ type CommentConnection struct {
 	Nodes []Comment `json:"nodes"`
 }
 
+// CommentMatch represents a comment matched by a body search, with its issue
+type CommentMatch struct {
+	ID        string    `json:"id"`
+	Body      string    `json:"body"`
+	URL       string    `json:"url,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	User      *User     `json:"user,omitempty"`
+	Issue     *Issue    `json:"issue,omitempty"`
+}
+
 // PageInfo represents pagination information
 type PageInfo struct {
 	HasNextPage bool   `json:"hasNextPage"`
@@ -137,6 +201,7 @@ type Comment struct {
 	Body      string             `json:"body"`
 	User      *User              `json:"user,omitempty"`
 	CreatedAt time.Time          `json:"createdAt"`
+	EditedAt  *time.Time         `json:"editedAt,omitempty"`
 	URL       string             `json:"url,omitempty"`
 	Parent    *Comment           `json:"parent,omitempty"`
 	Children  *CommentConnection `json:"children,omitempty"`
@@ -171,6 +236,31 @@ type Attachment struct {
 	CreatedAt  time.Time              `json:"createdAt"`
 }
 
+// Cycle represents a Linear cycle (sprint)
+type Cycle struct {
+	ID       string     `json:"id"`
+	Number   int        `json:"number"`
+	Name     string     `json:"name,omitempty"`
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+	EndsAt   *time.Time `json:"endsAt,omitempty"`
+}
+
+// IssueCycleHistoryEntry represents a history entry where an issue was moved into a cycle
+type IssueCycleHistoryEntry struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ToCycle   *Cycle    `json:"toCycle,omitempty"`
+}
+
+// IssueDraft represents a draft issue the viewer started but has not filed yet
+type IssueDraft struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	Team        *Team     `json:"team,omitempty"`
+}
+
 // Organization represents a Linear organization
 type Organization struct {
 	ID     string `json:"id"`
@@ -188,6 +278,7 @@ type LinearIssueResponse struct {
 	Priority         int               `json:"priority"`
 	Status           string            `json:"status,omitempty"`
 	StateName        string            `json:"stateName,omitempty"`
+	AssigneeName     string            `json:"assigneeName,omitempty"`
 	URL              string            `json:"url"`
 	Project          *Project          `json:"project,omitempty"`
 	ProjectMilestone *ProjectMilestone `json:"projectMilestone,omitempty"`
@@ -211,6 +302,11 @@ type CreateIssueInput struct {
 	Status      string   `json:"status,omitempty"`
 	ParentID    *string  `json:"parentId,omitempty"`
 	LabelIDs    []string `json:"labelIds,omitempty"`
+	DueDate     string   `json:"dueDate,omitempty"`
+	CycleID     string   `json:"cycleId,omitempty"`
+	AssigneeID  string   `json:"assigneeId,omitempty"`
+	ProjectID   string   `json:"projectId,omitempty"`
+	MilestoneID string   `json:"milestoneId,omitempty"`
 }
 
 // UpdateIssueInput represents input for updating an issue
@@ -223,6 +319,15 @@ type UpdateIssueInput struct {
 	TeamID      string `json:"teamId,omitempty"`
 	ProjectID   string `json:"projectId,omitempty"`
 	MilestoneID string `json:"milestoneId,omitempty"`
+	DueDate     string `json:"dueDate,omitempty"`
+	CycleID     string `json:"cycleId,omitempty"`
+	// AssigneeID sets the assignee when non-nil; an empty string clears the assignee
+	AssigneeID *string `json:"assigneeId,omitempty"`
+	// SortOrder positions the issue within its board column; lower values sort higher
+	SortOrder *float64 `json:"sortOrder,omitempty"`
+	// Estimate sets the issue's estimate when non-nil; only sent when explicitly
+	// provided. A negative value clears the estimate
+	Estimate *float64 `json:"estimate,omitempty"`
 }
 
 // SearchIssuesInput represents input for searching issues
@@ -231,11 +336,41 @@ type SearchIssuesInput struct {
 	TeamID          string   `json:"teamId,omitempty"`
 	Status          string   `json:"status,omitempty"`
 	AssigneeID      string   `json:"assigneeId,omitempty"`
+	ProjectID       string   `json:"projectId,omitempty"`
+	MilestoneID     string   `json:"milestoneId,omitempty"`
 	Labels          []string `json:"labels,omitempty"`
+	Parent          string   `json:"parent,omitempty"` // parent issue UUID, or "none" for top-level issues
 	Priority        *int     `json:"priority,omitempty"`
 	Estimate        *float64 `json:"estimate,omitempty"`
+	Unestimated     bool     `json:"unestimated,omitempty"`  // only issues without an estimate
+	OpenOnly        bool     `json:"openOnly,omitempty"`     // exclude completed and canceled states
+	CreatedAfter    string   `json:"createdAfter,omitempty"` // RFC3339 timestamp
+	CreatedBefore   string   `json:"createdBefore,omitempty"`
+	UpdatedAfter    string   `json:"updatedAfter,omitempty"`
+	UpdatedBefore   string   `json:"updatedBefore,omitempty"`
 	IncludeArchived bool     `json:"includeArchived,omitempty"`
 	Limit           int      `json:"limit,omitempty"`
+	AfterCursor     string   `json:"afterCursor,omitempty"`
+}
+
+// ListProjectsInput represents input for listing projects
+type ListProjectsInput struct {
+	TeamID      string `json:"teamId,omitempty"`
+	State       string `json:"state,omitempty"`
+	Limit       int    `json:"limit,omitempty"`
+	AfterCursor string `json:"afterCursor,omitempty"`
+}
+
+// PaginatedProjectConnection represents a paginated page of projects
+type PaginatedProjectConnection struct {
+	Nodes    []Project `json:"nodes"`
+	PageInfo PageInfo  `json:"pageInfo"`
+}
+
+// PaginatedIssueConnection represents a paginated page of search results
+type PaginatedIssueConnection struct {
+	Nodes    []LinearIssueResponse `json:"nodes"`
+	PageInfo PageInfo              `json:"pageInfo"`
 }
 
 // GetUserIssuesInput represents input for getting user issues
@@ -275,6 +410,7 @@ type ProjectCreateInput struct {
 	LeadID      string   `json:"leadId,omitempty"`
 	StartDate   string   `json:"startDate,omitempty"`
 	TargetDate  string   `json:"targetDate,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
 }
 
 // ProjectUpdateInput represents the input for updating a project.
@@ -285,6 +421,7 @@ type ProjectUpdateInput struct {
 	StartDate   string   `json:"startDate,omitempty"`
 	TargetDate  string   `json:"targetDate,omitempty"`
 	TeamIDs     []string `json:"teamIds,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
 }
 
 // ProjectMilestoneCreateInput represents the input for creating a project milestone.
@@ -306,12 +443,14 @@ type ProjectMilestoneUpdateInput struct {
 type InitiativeCreateInput struct {
 	Name        string `json:"name"`
 	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`
 }
 
 // InitiativeUpdateInput represents the input for updating an initiative.
 type InitiativeUpdateInput struct {
 	Name        string `json:"name,omitempty"`
 	Description string `json:"description,omitempty"`
+	Icon        string `json:"icon,omitempty"`
 }
 
 // GraphQLRequest represents a GraphQL request
@@ -1,7 +1,7 @@
 package linear
 
 import (
-	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 )
@@ -15,6 +15,9 @@ type RateLimiter struct {
 	lastRequestTime int64
 	requestTimes    []int64
 	requestDurations []int64
+	remaining       int
+	resetAt         time.Time
+	logger          *slog.Logger
 	mu              sync.Mutex
 }
 
@@ -27,8 +30,14 @@ type RateLimiterMetrics struct {
 	LastRequestTime    int64  `json:"lastRequestTime"`
 }
 
-// NewRateLimiter creates a new rate limiter with the specified requests per hour limit
-func NewRateLimiter(requestsPerHour int) *RateLimiter {
+// NewRateLimiter creates a new rate limiter with the specified requests per
+// hour limit. The logger receives the per-request enqueue/start/complete lines
+// at debug level; a nil logger falls back to NewLoggerFromEnv.
+func NewRateLimiter(requestsPerHour int, logger *slog.Logger) *RateLimiter {
+	if logger == nil {
+		logger = NewLoggerFromEnv()
+	}
+
 	minDelayMs := int64(3600000 / requestsPerHour)
 	rl := &RateLimiter{
 		requestsPerHour:  requestsPerHour,
@@ -38,6 +47,8 @@ func NewRateLimiter(requestsPerHour int) *RateLimiter {
 		lastRequestTime:  0,
 		requestTimes:     []int64{},
 		requestDurations: []int64{},
+		remaining:        -1, // Unknown until the first response reports it
+		logger:           logger,
 	}
 
 	// Start the queue processor
@@ -51,22 +62,20 @@ func (rl *RateLimiter) Enqueue(fn func() error, operation string) error {
 	startTime := time.Now().UnixMilli()
 	queuePosition := len(rl.queue)
 
-	fmt.Printf("[Linear API] Enqueueing request%s (Queue position: %d)\n", 
-		formatOperation(operation), queuePosition)
+	rl.logger.Debug("enqueueing Linear API request", "operation", operation, "queuePosition", queuePosition)
 
 	// Create a channel to receive the result
 	resultCh := make(chan error, 1)
 
 	// Wrap the function to capture its result
 	wrappedFn := func() error {
-		fmt.Printf("[Linear API] Starting request%s\n", formatOperation(operation))
+		rl.logger.Debug("starting Linear API request", "operation", operation)
 		result := fn()
 		endTime := time.Now().UnixMilli()
 		duration := endTime - startTime
 
-		fmt.Printf("[Linear API] Completed request%s (Duration: %dms)\n", 
-			formatOperation(operation), duration)
-		
+		rl.logger.Debug("completed Linear API request", "operation", operation, "durationMs", duration)
+
 		rl.trackRequest(startTime, endTime, operation)
 		resultCh <- result
 		return result
@@ -139,6 +148,17 @@ func (rl *RateLimiter) processQueue() {
 			rl.mu.Unlock()
 		}
 
+		// Pause until the server-reported window resets when it is exhausted
+		rl.mu.Lock()
+		if rl.remaining == 0 && time.Now().Before(rl.resetAt) {
+			waitTime := time.Until(rl.resetAt)
+			rl.mu.Unlock()
+			rl.logger.Warn("Linear API rate limit exhausted, waiting for reset", "wait", waitTime.Round(time.Second).String())
+			time.Sleep(waitTime)
+		} else {
+			rl.mu.Unlock()
+		}
+
 		// Execute the function
 		rl.mu.Lock()
 		rl.lastRequestTime = time.Now().UnixMilli()
@@ -148,6 +168,18 @@ func (rl *RateLimiter) processQueue() {
 	}
 }
 
+// UpdateFromHeaders feeds the server-reported rate limit state back into the
+// limiter: remaining is the number of requests left in the current window,
+// resetAt the instant the window resets. This replaces the static estimate
+// with the real count, so the limiter pauses exactly when Linear would 429.
+func (rl *RateLimiter) UpdateFromHeaders(remaining int, resetAt time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.remaining = remaining
+	rl.resetAt = resetAt
+}
+
 // trackRequest tracks a request for metrics
 func (rl *RateLimiter) trackRequest(startTime, endTime int64, operation string) {
 	duration := endTime - startTime
@@ -206,11 +238,3 @@ func (rl *RateLimiter) GetMetrics() RateLimiterMetrics {
 		LastRequestTime:    rl.lastRequestTime,
 	}
 }
-
-// Helper function to format operation name for logging
-func formatOperation(operation string) string {
-	if operation != "" {
-		return " for " + operation
-	}
-	return ""
-}
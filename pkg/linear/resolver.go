@@ -0,0 +1,59 @@
+package linear
+
+// Resolver provides cached access to organization and team data used when
+// resolving human-friendly identifiers (team names/keys, user names/emails).
+// The cache lives for the lifetime of the Resolver, which is intended to be a
+// single tool request, so that resolving e.g. a team, an assignee and labels
+// within one request does not hit the API repeatedly for the same data.
+type Resolver struct {
+	client *LinearClient
+
+	org   *Organization
+	teams []Team
+}
+
+// NewResolver creates a new Resolver backed by this client
+func (c *LinearClient) NewResolver() *Resolver {
+	return &Resolver{client: c}
+}
+
+// Client returns the underlying Linear client
+func (r *Resolver) Client() *LinearClient {
+	return r.client
+}
+
+// Organization returns the organization, fetching it at most once
+func (r *Resolver) Organization() (*Organization, error) {
+	if r.org != nil {
+		return r.org, nil
+	}
+
+	org, err := r.client.GetOrganization()
+	if err != nil {
+		return nil, err
+	}
+
+	r.org = org
+	return r.org, nil
+}
+
+// Teams returns all teams, fetching them at most once. If the organization has
+// already been fetched, its teams are reused instead of issuing another request.
+func (r *Resolver) Teams() ([]Team, error) {
+	if r.teams != nil {
+		return r.teams, nil
+	}
+
+	if r.org != nil && len(r.org.Teams) > 0 {
+		r.teams = r.org.Teams
+		return r.teams, nil
+	}
+
+	teams, err := r.client.GetTeams("")
+	if err != nil {
+		return nil, err
+	}
+
+	r.teams = teams
+	return r.teams, nil
+}
@@ -0,0 +1,57 @@
+package linear
+
+import (
+	"testing"
+)
+
+// TestResolverCachesLookups verifies that repeated team/organization lookups
+// through a Resolver are served from its cache. The cassette contains exactly
+// one teams request and one organization request; in replay-only mode a second
+// identical request would fail because the recorded interaction has already
+// been played.
+func TestResolverCachesLookups(t *testing.T) {
+	client, cleanup := NewTestClient(t, "resolver_caching", false)
+	defer cleanup()
+
+	resolver := client.NewResolver()
+
+	for i := 0; i < 3; i++ {
+		teams, err := resolver.Teams()
+		if err != nil {
+			t.Fatalf("Teams() call %d failed: %v", i+1, err)
+		}
+		if len(teams) == 0 {
+			t.Fatalf("Teams() call %d returned no teams", i+1)
+		}
+
+		org, err := resolver.Organization()
+		if err != nil {
+			t.Fatalf("Organization() call %d failed: %v", i+1, err)
+		}
+		if len(org.Users) == 0 {
+			t.Fatalf("Organization() call %d returned no users", i+1)
+		}
+	}
+}
+
+// TestResolverReusesOrganizationTeams verifies that a Resolver which already
+// fetched the organization answers Teams() from the organization's teams
+// without a dedicated teams request (the cassette contains none).
+func TestResolverReusesOrganizationTeams(t *testing.T) {
+	client, cleanup := NewTestClient(t, "resolver_org_teams", false)
+	defer cleanup()
+
+	resolver := client.NewResolver()
+
+	if _, err := resolver.Organization(); err != nil {
+		t.Fatalf("Organization() failed: %v", err)
+	}
+
+	teams, err := resolver.Teams()
+	if err != nil {
+		t.Fatalf("Teams() failed: %v", err)
+	}
+	if len(teams) == 0 {
+		t.Fatal("Teams() returned no teams")
+	}
+}
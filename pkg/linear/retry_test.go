@@ -0,0 +1,134 @@
+package linear
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// countingTransport fails every request with a 500 response and counts the attempts.
+type countingTransport struct {
+	calls int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return &http.Response{
+		StatusCode: http.StatusInternalServerError,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("server error")),
+	}, nil
+}
+
+func newRetryTestClient(transport http.RoundTripper, maxRetries int) *LinearClient {
+	client := &LinearClient{
+		apiKey:        "test-api-key",
+		httpClient:    &http.Client{Transport: transport},
+		rateLimiter:   NewRateLimiter(1400, NewLoggerFromEnv()),
+		serverVersion: "test",
+	}
+	client.SetMaxRetries(maxRetries)
+	return client
+}
+
+func TestMaxRetriesZeroDisablesRetries(t *testing.T) {
+	transport := &countingTransport{}
+	client := newRetryTestClient(transport, 0)
+
+	if _, err := client.executeGraphQL("query { viewer { id } }", nil); err == nil {
+		t.Fatal("expected error from failing request, got nil")
+	}
+	if transport.calls != 1 {
+		t.Errorf("expected 1 attempt with retries disabled, got %d", transport.calls)
+	}
+}
+
+func TestMaxRetriesPerformsRetries(t *testing.T) {
+	transport := &countingTransport{}
+	client := newRetryTestClient(transport, 2)
+
+	if _, err := client.executeGraphQL("query { viewer { id } }", nil); err == nil {
+		t.Fatal("expected error from failing request, got nil")
+	}
+	if transport.calls != 3 {
+		t.Errorf("expected 3 attempts (1 initial + 2 retries), got %d", transport.calls)
+	}
+}
+
+// flakyTransport answers with a sequence of status codes, then keeps returning
+// the last one. Non-200 responses carry an optional Retry-After header.
+type flakyTransport struct {
+	calls      int
+	statuses   []int
+	retryAfter string
+	body       string
+}
+
+func (t *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	status := t.statuses[len(t.statuses)-1]
+	if t.calls < len(t.statuses) {
+		status = t.statuses[t.calls]
+	}
+	t.calls++
+
+	header := http.Header{}
+	if status != http.StatusOK && t.retryAfter != "" {
+		header.Set("Retry-After", t.retryAfter)
+	}
+	body := "server error"
+	if status == http.StatusOK {
+		body = t.body
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func TestRetriesRecoverFromTransientErrors(t *testing.T) {
+	transport := &flakyTransport{
+		statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+		body:     `{"data":{"viewer":{"id":"u1"}}}`,
+	}
+	client := newRetryTestClient(transport, 2)
+
+	if _, err := client.executeGraphQL("query { viewer { id } }", nil); err != nil {
+		t.Fatalf("expected request to succeed after retries, got %v", err)
+	}
+	if transport.calls != 3 {
+		t.Errorf("expected 3 attempts (503, 503, 200), got %d", transport.calls)
+	}
+}
+
+func TestNonRetryable4xxFailsFast(t *testing.T) {
+	transport := &flakyTransport{statuses: []int{http.StatusBadRequest}}
+	client := newRetryTestClient(transport, 2)
+
+	if _, err := client.executeGraphQL("query { viewer { id } }", nil); err == nil {
+		t.Fatal("expected error from 400 response, got nil")
+	}
+	if transport.calls != 1 {
+		t.Errorf("expected 1 attempt for a non-retryable 4xx, got %d", transport.calls)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	client := newRetryTestClient(&countingTransport{}, 2)
+	client.retryBackoffBase = 100 * time.Millisecond
+
+	// A server-provided Retry-After wins over the computed backoff
+	if got := client.retryDelay(0, 2*time.Second); got != 2*time.Second {
+		t.Errorf("expected Retry-After to be honored, got %v", got)
+	}
+
+	// Without Retry-After the delay doubles per attempt, with jitter in [delay/2, delay]
+	for attempt, max := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond} {
+		got := client.retryDelay(attempt, 0)
+		if got < max/2 || got > max {
+			t.Errorf("attempt %d: expected delay in [%v, %v], got %v", attempt, max/2, max, got)
+		}
+	}
+}
@@ -64,7 +64,7 @@ func NewTestClient(t *testing.T, cassetteName string, record bool) (*LinearClien
 	client := &LinearClient{
 		apiKey:      apiKey,
 		httpClient:  r.GetDefaultClient(),
-		rateLimiter: NewRateLimiter(1400),
+		rateLimiter: NewRateLimiter(1400, NewLoggerFromEnv()),
 	}
 
 	// Return the client and a cleanup function
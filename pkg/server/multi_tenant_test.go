@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// requestWithAPIKey builds a tool call request carrying the given API key in
+// its metadata, the way a multi-tenant client would send it.
+func requestWithAPIKey(apiKey string) mcp.CallToolRequest {
+	request := mcp.CallToolRequest{}
+	if apiKey != "" {
+		request.Params.Meta = &mcp.Meta{AdditionalFields: map[string]any{"linearApiKey": apiKey}}
+	}
+	return request
+}
+
+func TestClientForRequestSelectsByKey(t *testing.T) {
+	registry := linear.NewClientRegistry(ServerVersion)
+
+	clientA, errResult := clientForRequest(registry, requestWithAPIKey("lin_api_tenant_a"))
+	if errResult != nil {
+		t.Fatalf("expected client for tenant a, got error result: %+v", errResult)
+	}
+
+	// The same key must map to the same client (and thus the same rate limiter)
+	clientA2, errResult := clientForRequest(registry, requestWithAPIKey("lin_api_tenant_a"))
+	if errResult != nil {
+		t.Fatalf("expected client for tenant a, got error result: %+v", errResult)
+	}
+	if clientA != clientA2 {
+		t.Error("expected repeated requests with the same key to share a client")
+	}
+
+	// A different key must get its own client
+	clientB, errResult := clientForRequest(registry, requestWithAPIKey("lin_api_tenant_b"))
+	if errResult != nil {
+		t.Fatalf("expected client for tenant b, got error result: %+v", errResult)
+	}
+	if clientA == clientB {
+		t.Error("expected different keys to get different clients")
+	}
+}
+
+func TestClientForRequestMissingKey(t *testing.T) {
+	registry := linear.NewClientRegistry(ServerVersion)
+
+	// No metadata at all
+	client, errResult := clientForRequest(registry, requestWithAPIKey(""))
+	if client != nil || errResult == nil || !errResult.IsError {
+		t.Errorf("expected an error result for a request without metadata, got client=%v result=%+v", client, errResult)
+	}
+
+	// Metadata present but without the key field
+	request := mcp.CallToolRequest{}
+	request.Params.Meta = &mcp.Meta{AdditionalFields: map[string]any{"other": "value"}}
+	client, errResult = clientForRequest(registry, request)
+	if client != nil || errResult == nil || !errResult.IsError {
+		t.Errorf("expected an error result for a request without an API key, got client=%v result=%+v", client, errResult)
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -21,7 +22,9 @@ const (
 type LinearMCPServer struct {
 	mcpServer    *mcpserver.MCPServer
 	linearClient *linear.LinearClient
-	writeAccess  bool // Controls whether write operations are enabled
+	registry     *linear.ClientRegistry // Per-API-key clients in multi-tenant mode, nil otherwise
+	writeAccess  bool                   // Controls whether write operations are enabled
+	multiTenant  bool                   // API key comes from request metadata instead of the environment
 }
 
 // NewLinearMCPServer creates a new Linear MCP server
@@ -51,15 +54,44 @@ func NewLinearMCPServer(writeAccess bool) (*LinearMCPServer, error) {
 	return server, nil
 }
 
+// NewMultiTenantLinearMCPServer creates a Linear MCP server that reads the
+// Linear API key from each request's metadata instead of the environment.
+// Every distinct key gets its own client and rate limiter, so a shared
+// deployment can serve multiple users against their own Linear workspaces.
+func NewMultiTenantLinearMCPServer(writeAccess bool) (*LinearMCPServer, error) {
+	// Create the MCP server
+	mcpServer := mcpserver.NewMCPServer(ServerName, ServerVersion)
+
+	registry := linear.NewClientRegistry(ServerVersion)
+
+	// Create the Linear MCP server
+	server := &LinearMCPServer{
+		mcpServer:   mcpServer,
+		registry:    registry,
+		writeAccess: writeAccess,
+		multiTenant: true,
+	}
+
+	// Register tools; each invocation resolves its client from the request
+	RegisterMultiTenantTools(mcpServer, registry, writeAccess)
+
+	// Resources have no per-request metadata to carry a key, so they are not
+	// available in multi-tenant mode
+
+	return server, nil
+}
+
 // Start starts the Linear MCP server
 func (s *LinearMCPServer) Start() error {
-	// Check if the Linear API key is set
-	if os.Getenv("LINEAR_API_KEY") == "" {
+	// Check if the Linear API key is set; in multi-tenant mode it arrives with
+	// each request instead
+	if !s.multiTenant && os.Getenv("LINEAR_API_KEY") == "" {
 		return fmt.Errorf("LINEAR_API_KEY environment variable is required")
 	}
 
-	// Start the server
-	fmt.Printf("Starting %s v%s\n", ServerName, ServerVersion)
+	// Start the server; diagnostics go to stderr so stdout stays reserved for
+	// JSON-RPC frames under the stdio transport
+	fmt.Fprintf(os.Stderr, "Starting %s v%s\n", ServerName, ServerVersion)
 	return mcpserver.ServeStdio(s.mcpServer)
 }
 
@@ -68,57 +100,186 @@ func (s *LinearMCPServer) GetMCPServer() *mcpserver.MCPServer {
 	return s.mcpServer
 }
 
-// GetLinearClient returns the Linear client
+// GetLinearClient returns the Linear client. It is nil in multi-tenant mode,
+// where clients are constructed per request.
 func (s *LinearMCPServer) GetLinearClient() *linear.LinearClient {
 	return s.linearClient
 }
 
+// GetClientRegistry returns the per-key client registry. It is nil outside of
+// multi-tenant mode.
+func (s *LinearMCPServer) GetClientRegistry() *linear.ClientRegistry {
+	return s.registry
+}
+
 // GetReadOnlyToolNames returns the names of all read-only tools
 func GetReadOnlyToolNames() map[string]bool {
 	return map[string]bool{
-		"linear_search_issues":       true,
-		"linear_get_user_issues":     true,
-		"linear_get_issue":           true,
-		"linear_get_issue_comments":  true,
-		"linear_get_teams":           true,
-		"linear_get_project":         true,
-		"linear_search_projects":     true,
-		"linear_get_milestone":       true,
-		"linear_get_initiative":      true,
+		"linear_search_issues":           true,
+		"linear_completed_since":         true,
+		"linear_cycle_new_issues":        true,
+		"linear_stale_issues":            true,
+		"linear_compare_issues":          true,
+		"linear_get_user_issues":         true,
+		"linear_get_issue":               true,
+		"linear_get_issue_ancestors":     true,
+		"linear_get_issue_comments":      true,
+		"linear_get_comment_thread":      true,
+		"linear_comment_count":           true,
+		"linear_get_issue_document":      true,
+		"linear_get_teams":               true,
+		"linear_get_team":                true,
+		"linear_list_users":              true,
+		"linear_get_viewer":              true,
+		"linear_get_drafts":              true,
+		"linear_get_project":             true,
+		"linear_get_project_issues":      true,
+		"linear_search_projects":         true,
+		"linear_list_projects":           true,
+		"linear_get_milestone":           true,
+		"linear_list_milestones":         true,
+		"linear_project_timeline":        true,
+		"linear_get_initiative":          true,
+		"linear_get_initiative_updates":  true,
+		"linear_get_labels":              true,
+		"linear_label_usage":             true,
+		"linear_list_cycles":             true,
+		"linear_issues_by_label":         true,
+		"linear_my_work":                 true,
+		"linear_mentions":                true,
+		"linear_resolve_issues":          true,
+		"linear_resolve_url":             true,
+		"linear_get_team_status_options": true,
+		"linear_team_workload":           true,
+		"linear_board":                   true,
+		"linear_wait_for_change":         true,
+		"linear_api_metrics":             true,
 	}
 }
 
+// toolHandlerFactory builds a tool handler bound to a concrete Linear client.
+// It matches the signature of the handler constructors in pkg/tools.
+type toolHandlerFactory = func(*linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error)
+
 // RegisterTools registers all Linear tools with the MCP server
 func RegisterTools(s *mcpserver.MCPServer, linearClient *linear.LinearClient, writeAccess bool) {
-	// Register tools, based on writeAccess
-	addTool := func(tool mcp.Tool, handler mcpserver.ToolHandlerFunc) {
+	registerTools(s, writeAccess, func(makeHandler toolHandlerFactory) mcpserver.ToolHandlerFunc {
+		return makeHandler(linearClient)
+	})
+}
+
+// RegisterMultiTenantTools registers all Linear tools with the MCP server,
+// resolving the Linear client from each request's metadata via the registry.
+func RegisterMultiTenantTools(s *mcpserver.MCPServer, registry *linear.ClientRegistry, writeAccess bool) {
+	registerTools(s, writeAccess, func(makeHandler toolHandlerFactory) mcpserver.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			linearClient, errResult := clientForRequest(registry, request)
+			if errResult != nil {
+				return errResult, nil
+			}
+			return makeHandler(linearClient)(ctx, request)
+		}
+	})
+}
+
+// clientForRequest resolves the Linear client for a multi-tenant request from
+// the 'linearApiKey' request metadata field.
+func clientForRequest(registry *linear.ClientRegistry, request mcp.CallToolRequest) (*linear.LinearClient, *mcp.CallToolResult) {
+	apiKey := ""
+	if request.Params.Meta != nil {
+		apiKey, _ = request.Params.Meta.AdditionalFields["linearApiKey"].(string)
+	}
+	if apiKey == "" {
+		return nil, &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "Missing Linear API key: set the 'linearApiKey' field in the request metadata (_meta)"}}}
+	}
+
+	linearClient, err := registry.ClientFor(apiKey)
+	if err != nil {
+		return nil, &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to create Linear client: %v", err)}}}
+	}
+	return linearClient, nil
+}
+
+// registerTools registers each tool, based on writeAccess, binding the handler
+// factory to a client via the given bind function.
+func registerTools(s *mcpserver.MCPServer, writeAccess bool, bind func(toolHandlerFactory) mcpserver.ToolHandlerFunc) {
+	addTool := func(tool mcp.Tool, makeHandler toolHandlerFactory) {
 		if !writeAccess {
 			if readOnly := GetReadOnlyToolNames()[tool.Name]; !readOnly {
 				// Skip registering write tools if write access is disabled
 				return
 			}
 		}
-		s.AddTool(tool, handler)
+		s.AddTool(tool, bind(makeHandler))
 	}
 
 	// Register each tool
-	addTool(tools.SearchIssuesTool, tools.SearchIssuesHandler(linearClient))
-	addTool(tools.GetUserIssuesTool, tools.GetUserIssuesHandler(linearClient))
-	addTool(tools.GetIssueTool, tools.GetIssueHandler(linearClient))
-	addTool(tools.GetIssueCommentsTool, tools.GetIssueCommentsHandler(linearClient))
-	addTool(tools.GetTeamsTool, tools.GetTeamsHandler(linearClient))
-	addTool(tools.GetProjectTool, tools.GetProjectHandler(linearClient))
-	addTool(tools.SearchProjectsTool, tools.SearchProjectsHandler(linearClient))
-	addTool(tools.CreateProjectTool, tools.CreateProjectHandler(linearClient))
-	addTool(tools.UpdateProjectTool, tools.UpdateProjectHandler(linearClient))
-	addTool(tools.GetMilestoneTool, tools.GetMilestoneHandler(linearClient))
-	addTool(tools.CreateMilestoneTool, tools.CreateMilestoneHandler(linearClient))
-	addTool(tools.UpdateMilestoneTool, tools.UpdateMilestoneHandler(linearClient))
-	addTool(tools.GetInitiativeTool, tools.GetInitiativeHandler(linearClient))
-	addTool(tools.CreateInitiativeTool, tools.CreateInitiativeHandler(linearClient))
-	addTool(tools.UpdateInitiativeTool, tools.UpdateInitiativeHandler(linearClient))
-	addTool(tools.CreateIssueTool, tools.CreateIssueHandler(linearClient))
-	addTool(tools.UpdateIssueTool, tools.UpdateIssueHandler(linearClient))
-	addTool(tools.AddCommentTool, tools.AddCommentHandler(linearClient))
-	addTool(tools.UpdateCommentTool, tools.UpdateCommentHandler(linearClient))
+	addTool(tools.SearchIssuesTool, tools.SearchIssuesHandler)
+	addTool(tools.CompletedSinceTool, tools.CompletedSinceHandler)
+	addTool(tools.StaleIssuesTool, tools.StaleIssuesHandler)
+	addTool(tools.GetUserIssuesTool, tools.GetUserIssuesHandler)
+	addTool(tools.MyWorkTool, tools.MyWorkHandler)
+	addTool(tools.MentionsTool, tools.MentionsHandler)
+	addTool(tools.GetIssueTool, tools.GetIssueHandler)
+	addTool(tools.GetIssueAncestorsTool, tools.GetIssueAncestorsHandler)
+	addTool(tools.ResolveIssuesTool, tools.ResolveIssuesHandler)
+	addTool(tools.GetIssueCommentsTool, tools.GetIssueCommentsHandler)
+	addTool(tools.GetCommentThreadTool, tools.GetCommentThreadHandler)
+	addTool(tools.CommentCountTool, tools.CommentCountHandler)
+	addTool(tools.GetIssueDocumentTool, tools.GetIssueDocumentHandler)
+	addTool(tools.CompareIssuesTool, tools.CompareIssuesHandler)
+	addTool(tools.ResolveURLTool, tools.ResolveURLHandler)
+	addTool(tools.GetTeamsTool, tools.GetTeamsHandler)
+	addTool(tools.GetTeamTool, tools.GetTeamHandler)
+	addTool(tools.ListUsersTool, tools.ListUsersHandler)
+	addTool(tools.GetViewerTool, tools.GetViewerHandler)
+	addTool(tools.GetDraftsTool, tools.GetDraftsHandler)
+	addTool(tools.GetLabelsTool, tools.GetLabelsHandler)
+	addTool(tools.LabelUsageTool, tools.LabelUsageHandler)
+	addTool(tools.IssuesByLabelTool, tools.IssuesByLabelHandler)
+	addTool(tools.GetTeamStatusOptionsTool, tools.GetTeamStatusOptionsHandler)
+	addTool(tools.TeamWorkloadTool, tools.TeamWorkloadHandler)
+	addTool(tools.BoardTool, tools.BoardHandler)
+	addTool(tools.ListCyclesTool, tools.ListCyclesHandler)
+	addTool(tools.CycleNewIssuesTool, tools.CycleNewIssuesHandler)
+	addTool(tools.WaitForChangeTool, tools.WaitForChangeHandler)
+	addTool(tools.APIMetricsTool, tools.APIMetricsHandler)
+	addTool(tools.GetProjectTool, tools.GetProjectHandler)
+	addTool(tools.GetProjectIssuesTool, tools.GetProjectIssuesHandler)
+	addTool(tools.SearchProjectsTool, tools.SearchProjectsHandler)
+	addTool(tools.ListProjectsTool, tools.ListProjectsHandler)
+	addTool(tools.CreateProjectTool, tools.CreateProjectHandler)
+	addTool(tools.UpdateProjectTool, tools.UpdateProjectHandler)
+	addTool(tools.WatchProjectTool, tools.WatchProjectHandler)
+	addTool(tools.AddProjectMemberTool, tools.AddProjectMemberHandler)
+	addTool(tools.RemoveProjectMemberTool, tools.RemoveProjectMemberHandler)
+	addTool(tools.GetMilestoneTool, tools.GetMilestoneHandler)
+	addTool(tools.ListMilestonesTool, tools.ListMilestonesHandler)
+	addTool(tools.ProjectTimelineTool, tools.ProjectTimelineHandler)
+	addTool(tools.CreateMilestoneTool, tools.CreateMilestoneHandler)
+	addTool(tools.UpdateMilestoneTool, tools.UpdateMilestoneHandler)
+	addTool(tools.GetInitiativeTool, tools.GetInitiativeHandler)
+	addTool(tools.GetInitiativeUpdatesTool, tools.GetInitiativeUpdatesHandler)
+	addTool(tools.CreateInitiativeTool, tools.CreateInitiativeHandler)
+	addTool(tools.UpdateInitiativeTool, tools.UpdateInitiativeHandler)
+	addTool(tools.CreateIssueTool, tools.CreateIssueHandler)
+	addTool(tools.UpdateIssueTool, tools.UpdateIssueHandler)
+	addTool(tools.AssignIssueTool, tools.AssignIssueHandler)
+	addTool(tools.DeleteIssueTool, tools.DeleteIssueHandler)
+	addTool(tools.CreateIssueRelationTool, tools.CreateIssueRelationHandler)
+	addTool(tools.DeleteIssueRelationTool, tools.DeleteIssueRelationHandler)
+	addTool(tools.ArchiveIssueTool, tools.ArchiveIssueHandler)
+	addTool(tools.UnarchiveIssueTool, tools.UnarchiveIssueHandler)
+	addTool(tools.SetIssueLabelsTool, tools.SetIssueLabelsHandler)
+	addTool(tools.BulkLabelTool, tools.BulkLabelHandler)
+	addTool(tools.BulkMoveToProjectTool, tools.BulkMoveToProjectHandler)
+	addTool(tools.CreateLabelTool, tools.CreateLabelHandler)
+	addTool(tools.DeleteLabelTool, tools.DeleteLabelHandler)
+	addTool(tools.AddAttachmentTool, tools.AddAttachmentHandler)
+	addTool(tools.DeleteAttachmentTool, tools.DeleteAttachmentHandler)
+	addTool(tools.AddCommentTool, tools.AddCommentHandler)
+	addTool(tools.BroadcastCommentTool, tools.BroadcastCommentHandler)
+	addTool(tools.CommentToIssueTool, tools.CommentToIssueHandler)
+	addTool(tools.UpdateCommentTool, tools.UpdateCommentHandler)
+	addTool(tools.ResolveCommentTool, tools.ResolveCommentHandler)
 }
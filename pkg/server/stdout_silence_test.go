@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/geropl/linear-mcp-go/pkg/tools"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestHandlerKeepsStdoutClean verifies that a handler call writes nothing to
+// stdout. Under the stdio transport stdout carries only JSON-RPC frames, so
+// any stray diagnostic output there corrupts the protocol stream.
+func TestHandlerKeepsStdoutClean(t *testing.T) {
+	client, cleanup := linear.NewTestClient(t, "stdout_silence", false)
+	defer cleanup()
+
+	// Capture stdout for the duration of the handler call
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	origStdout := os.Stdout
+	os.Stdout = writeEnd
+	defer func() { os.Stdout = origStdout }()
+
+	handler := tools.GetIssueHandler(client)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "linear_get_issue"
+	request.Params.Arguments = map[string]interface{}{
+		"issue": ISSUE_ID,
+	}
+
+	result, handlerErr := handler(context.Background(), request)
+
+	os.Stdout = origStdout
+	writeEnd.Close()
+	captured, err := io.ReadAll(readEnd)
+	if err != nil {
+		t.Fatalf("failed to read captured stdout: %v", err)
+	}
+
+	if handlerErr != nil {
+		t.Fatalf("Handler returned error: %v", handlerErr)
+	}
+	if result.IsError {
+		t.Fatalf("Handler returned error result: %+v", result.Content)
+	}
+
+	if len(captured) > 0 {
+		t.Errorf("handler wrote to stdout: %q", string(captured))
+	}
+}
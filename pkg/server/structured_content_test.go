@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/geropl/linear-mcp-go/pkg/tools"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TestStructuredContent verifies that handlers return both the human-readable
+// text block and the structured JSON block when 'structured' is enabled.
+func TestStructuredContent(t *testing.T) {
+	client, cleanup := linear.NewTestClient(t, "structured_content", false)
+	defer cleanup()
+
+	handler := tools.GetIssueHandler(client)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "linear_get_issue"
+	request.Params.Arguments = map[string]interface{}{
+		"issue":      ISSUE_ID,
+		"structured": true,
+	}
+
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Handler returned error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Handler returned error result: %+v", result.Content)
+	}
+
+	if len(result.Content) != 2 {
+		t.Fatalf("expected 2 content blocks, got %d", len(result.Content))
+	}
+
+	textBlock, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("expected the first block to be text content, got %T", result.Content[0])
+	}
+	if textBlock.Text == "" {
+		t.Error("expected a non-empty text block")
+	}
+
+	jsonBlock, ok := result.Content[1].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("expected the second block to be text content, got %T", result.Content[1])
+	}
+
+	var issue linear.Issue
+	if err := json.Unmarshal([]byte(jsonBlock.Text), &issue); err != nil {
+		t.Fatalf("expected the second block to be valid JSON: %v", err)
+	}
+	if issue.Identifier != ISSUE_ID {
+		t.Errorf("expected the structured issue to have identifier '%s', got '%s'", ISSUE_ID, issue.Identifier)
+	}
+}
@@ -29,6 +29,173 @@ func TestHandlers(t *testing.T) {
 				"name": TEAM_NAME,
 			},
 		},
+		{
+			handler: "get_teams",
+			name:    "JSON format",
+			args: map[string]interface{}{
+				"name":   TEAM_NAME,
+				"format": "json",
+			},
+		},
+		// LabelUsageHandler test cases
+		{
+			handler: "label_usage",
+			name:    "List labels",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "label_usage",
+			name:    "Busy label",
+			args:    map[string]interface{}{},
+		},
+		// IssuesByLabelHandler test cases
+		{
+			handler: "issues_by_label",
+			name:    "With issues",
+			args: map[string]interface{}{
+				"team":          TEAM_ID,
+				"includeIssues": true,
+			},
+		},
+		{
+			handler: "issues_by_label",
+			name:    "Missing team",
+			args:    map[string]interface{}{},
+		},
+		// GetTeamStatusOptionsHandler test cases
+		{
+			handler: "get_team_status_options",
+			name:    "Valid team",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+			},
+		},
+		{
+			handler: "get_team_status_options",
+			name:    "Missing team",
+			args:    map[string]interface{}{},
+		},
+		// WatchProjectHandler test cases
+		{
+			handler: "watch_project",
+			name:    "Watch project",
+			args: map[string]interface{}{
+				"project": "01bff2dd-ab7f-4464-b425-97073862013f",
+			},
+			write: true,
+		},
+		{
+			handler: "watch_project",
+			name:    "Dry run",
+			args: map[string]interface{}{
+				"project": "01bff2dd-ab7f-4464-b425-97073862013f",
+				"dryRun":  true,
+			},
+			write: true,
+		},
+		{
+			handler: "watch_project",
+			name:    "Missing project",
+			args:    map[string]interface{}{},
+			write:   true,
+		},
+
+		// CreateLabelHandler test cases
+		{
+			handler: "create_label",
+			name:    "Valid label",
+			args: map[string]interface{}{
+				"team":  TEAM_ID,
+				"name":  "Docs",
+				"color": "#a1b2c3",
+			},
+			write: true,
+		},
+		{
+			handler: "create_label",
+			name:    "Existing label",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+				"name": "Docs",
+			},
+			write: true,
+		},
+		{
+			handler: "create_label",
+			name:    "Invalid color",
+			args: map[string]interface{}{
+				"team":  TEAM_ID,
+				"name":  "Docs",
+				"color": "red",
+			},
+			write: true,
+		},
+
+		// DeleteLabelHandler test cases
+		{
+			handler: "delete_label",
+			name:    "Valid delete",
+			args: map[string]interface{}{
+				"label":   "3f2a1b0c-9d8e-4f70-a1b2-c3d4e5f60718",
+				"confirm": true,
+			},
+			write: true,
+		},
+		{
+			handler: "delete_label",
+			name:    "Not confirmed",
+			args: map[string]interface{}{
+				"label": "3f2a1b0c-9d8e-4f70-a1b2-c3d4e5f60718",
+			},
+			write: true,
+		},
+
+		// ListCyclesHandler test cases
+		{
+			handler: "list_cycles",
+			name:    "Valid team",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+			},
+		},
+		{
+			handler: "list_cycles",
+			name:    "Missing team",
+			args:    map[string]interface{}{},
+		},
+		// TeamWorkloadHandler test cases
+		{
+			handler: "team_workload",
+			name:    "Valid team",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+			},
+		},
+		{
+			handler: "team_workload",
+			name:    "Missing team",
+			args:    map[string]interface{}{},
+		},
+		// GetLabelsHandler test cases
+		{
+			handler: "get_labels",
+			name:    "Valid team",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+			},
+		},
+		{
+			handler: "get_labels",
+			name:    "Paginated",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+			},
+		},
+		{
+			handler: "get_labels",
+			name:    "Missing team",
+			args:    map[string]interface{}{},
+		},
 		// CreateIssueHandler test cases
 		{
 			handler: "create_issue",
@@ -39,6 +206,37 @@ func TestHandlers(t *testing.T) {
 			},
 			write: true,
 		},
+		{
+			handler: "create_issue",
+			name:    "With comment",
+			args: map[string]interface{}{
+				"title":   "Bug with repro",
+				"team":    TEAM_ID,
+				"comment": "Repro: run make test twice",
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue",
+			name:    "With assignee",
+			args: map[string]interface{}{
+				"title":    "Assigned bug",
+				"team":     "TEST",
+				"assignee": "Gero Leinemann",
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue",
+			name:    "Into project and milestone",
+			args: map[string]interface{}{
+				"title":     "Scoped to milestone",
+				"team":      TEAM_ID,
+				"project":   "01bff2dd-ab7f-4464-b425-97073862013f",
+				"milestone": "c86acc00-3035-4a67-82f2-2a5bf6453e92",
+			},
+			write: true,
+		},
 		{
 			handler: "create_issue",
 			name:    "Valid issue with team UUID",
@@ -48,6 +246,16 @@ func TestHandlers(t *testing.T) {
 			},
 			write: true,
 		},
+		{
+			handler: "create_issue",
+			name:    "Into current cycle",
+			args: map[string]interface{}{
+				"title": "Issue in current cycle",
+				"team":  TEAM_ID,
+				"cycle": "current",
+			},
+			write: true,
+		},
 		{
 			handler: "create_issue",
 			name:    "Valid issue with team name",
@@ -61,199 +269,949 @@ func TestHandlers(t *testing.T) {
 			handler: "create_issue",
 			name:    "Valid issue with team key",
 			args: map[string]interface{}{
-				"title": "Test Issue with team key",
-				"team":  TEAM_KEY,
+				"title": "Test Issue with team key",
+				"team":  TEAM_KEY,
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue",
+			name:    "Create sub issue",
+			args: map[string]interface{}{
+				"title":       "Sub Issue",
+				"team":        TEAM_ID,
+				"parentIssue": "1c2de93f-4321-4015-bfde-ee893ef7976f", // UUID for TEST-10
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue",
+			name:    "Create sub issue from identifier",
+			args: map[string]interface{}{
+				"title":       "Sub Issue",
+				"team":        TEAM_ID,
+				"parentIssue": "TEST-10",
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue",
+			name:    "Create issue with labels",
+			args: map[string]interface{}{
+				"title":  "Issue with Labels",
+				"team":   TEAM_ID,
+				"labels": "team label 1",
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue",
+			name:    "Create sub issue with labels",
+			args: map[string]interface{}{
+				"title":       "Sub Issue with Labels",
+				"team":        TEAM_ID,
+				"parentIssue": "1c2de93f-4321-4015-bfde-ee893ef7976f", // UUID for TEST-10
+				"labels":      "ws-label 2,Feature",
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue",
+			name:    "Cross-team label",
+			args: map[string]interface{}{
+				"title":  "Issue with foreign label",
+				"team":   TEAM_ID,
+				"labels": "Design System",
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue",
+			name:    "Missing title",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+			},
+		},
+		{
+			handler: "create_issue",
+			name:    "Missing team",
+			args: map[string]interface{}{
+				"title": "Test Issue",
+			},
+		},
+		{
+			handler: "create_issue",
+			name:    "Invalid team",
+			args: map[string]interface{}{
+				"title": "Test Issue",
+				"team":  "NonExistentTeam",
+			},
+		},
+
+		// UpdateIssueHandler test cases
+		{
+			handler: "update_issue",
+			name:    "Valid update",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+				"title": "Updated Test Issue",
+			},
+			write: true,
+		},
+		{
+			handler: "update_issue",
+			name:    "Set position",
+			args: map[string]interface{}{
+				"issue":    ISSUE_ID,
+				"position": float64(-50.25),
+			},
+			write: true,
+		},
+		{
+			handler: "update_issue",
+			name:    "Set estimate",
+			args: map[string]interface{}{
+				"issue":    ISSUE_ID,
+				"estimate": float64(3),
+			},
+			write: true,
+		},
+		{
+			handler: "update_issue",
+			name:    "Clear estimate",
+			args: map[string]interface{}{
+				"issue":    ISSUE_ID,
+				"estimate": float64(-1),
+			},
+			write: true,
+		},
+		{
+			handler: "update_issue",
+			name:    "Move to cycle by number",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+				"cycle": "3",
+			},
+			write: true,
+		},
+		{
+			handler: "update_issue",
+			name:    "Move to next cycle",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+				"cycle": "next",
+			},
+			write: true,
+		},
+		{
+			handler: "update_issue",
+			name:    "Ambiguous team",
+			args: map[string]interface{}{
+				"issue": "1c2de93f-4321-4015-bfde-ee893ef7976f",
+				"team":  "Duplicate",
+			},
+			write: true,
+		},
+		{
+			handler: "update_issue",
+			name:    "Missing id",
+			args: map[string]interface{}{
+				"title": "Updated Test Issue",
+			},
+		},
+
+		// DeleteIssueHandler test cases
+		{
+			handler: "delete_issue",
+			name:    "Valid delete",
+			args: map[string]interface{}{
+				"issue":   "TEST-42",
+				"confirm": true,
+			},
+			write: true,
+		},
+		{
+			handler: "delete_issue",
+			name:    "Missing confirmation",
+			args: map[string]interface{}{
+				"issue": "TEST-42",
+			},
+		},
+		{
+			handler: "delete_issue",
+			name:    "Missing issue",
+			args:    map[string]interface{}{},
+		},
+
+		// SetIssueLabelsHandler test cases
+		{
+			handler: "set_issue_labels",
+			name:    "Create missing label",
+			args: map[string]interface{}{
+				"issue":         ISSUE_ID,
+				"labels":        "Bug,NewLabel",
+				"createMissing": true,
+			},
+			write: true,
+		},
+		{
+			handler: "set_issue_labels",
+			name:    "Clear labels",
+			args: map[string]interface{}{
+				"issue":  ISSUE_ID,
+				"labels": "",
+			},
+			write: true,
+		},
+		{
+			handler: "set_issue_labels",
+			name:    "Missing labels param",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+			},
+			write: true,
+		},
+
+		// BulkLabelHandler test cases
+		{
+			handler: "bulk_label",
+			name:    "Dry run",
+			args: map[string]interface{}{
+				"team":   TEAM_ID,
+				"labels": "Bug",
+				"query":  "backfill",
+				"dryRun": true,
+			},
+			write: true,
+		},
+		{
+			handler: "bulk_label",
+			name:    "Apply labels",
+			args: map[string]interface{}{
+				"team":      TEAM_ID,
+				"labels":    "3f2a1b0c-9d8e-4f70-a1b2-c3d4e5f60718",
+				"query":     "backfill",
+				"maxIssues": float64(10),
+			},
+			write: true,
+		},
+
+		// BulkMoveToProjectHandler test cases
+		{
+			handler: "bulk_move_to_project",
+			name:    "Dry run",
+			args: map[string]interface{}{
+				"team":    TEAM_ID,
+				"project": "01bff2dd-ab7f-4464-b425-97073862013f",
+				"query":   "backfill",
+				"dryRun":  true,
+			},
+			write: true,
+		},
+		{
+			handler: "bulk_move_to_project",
+			name:    "Move issues",
+			args: map[string]interface{}{
+				"team":      TEAM_ID,
+				"project":   "01bff2dd-ab7f-4464-b425-97073862013f",
+				"query":     "backfill",
+				"maxIssues": float64(10),
+			},
+			write: true,
+		},
+
+		// SearchIssuesHandler test cases
+		{
+			handler: "search_issues",
+			name:    "CSV format",
+			args: map[string]interface{}{
+				"team":   TEAM_ID,
+				"format": "csv",
+				"limit":  float64(5),
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Multiple statuses",
+			args: map[string]interface{}{
+				"team":   TEAM_ID,
+				"status": "In Progress, In Review",
+				"limit":  float64(5),
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Search by team",
+			args: map[string]interface{}{
+				"team":  TEAM_ID,
+				"limit": float64(5),
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Search by query",
+			args: map[string]interface{}{
+				"query": "test",
+				"limit": float64(5),
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Paginated search",
+			args: map[string]interface{}{
+				"team":  TEAM_ID,
+				"limit": float64(1),
+				"after": "cursor-te1a2b3c",
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Unestimated issues",
+			args: map[string]interface{}{
+				"team":        TEAM_ID,
+				"unestimated": true,
+				"limit":       float64(5),
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Unestimated with estimate",
+			args: map[string]interface{}{
+				"unestimated": true,
+				"estimate":    float64(3),
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Created in window",
+			args: map[string]interface{}{
+				"team":          TEAM_ID,
+				"createdAfter":  "2025-06-01",
+				"createdBefore": "2025-06-30",
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Invalid date filter",
+			args: map[string]interface{}{
+				"createdAfter": "junk",
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Search by project",
+			args: map[string]interface{}{
+				"project": "01bff2dd-ab7f-4464-b425-97073862013f",
+				"limit":   float64(5),
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "Search by project and milestone",
+			args: map[string]interface{}{
+				"project":   "01bff2dd-ab7f-4464-b425-97073862013f",
+				"milestone": "c86acc00-3035-4a67-82f2-2a5bf6453e92",
+				"limit":     float64(5),
+			},
+		},
+
+		{
+			handler: "search_issues",
+			name:    "Top-level issues",
+			args: map[string]interface{}{
+				"team":   TEAM_ID,
+				"parent": "none",
+				"limit":  float64(5),
+			},
+		},
+		{
+			handler: "search_issues",
+			name:    "By parent issue",
+			args: map[string]interface{}{
+				"parent": "TEST-10",
+				"limit":  float64(5),
+			},
+		},
+
+		// GetUserIssuesHandler test cases
+		{
+			handler: "get_user_issues",
+			name:    "Current user issues",
+			args: map[string]interface{}{
+				"limit": float64(5),
+			},
+		},
+		{
+			handler: "get_user_issues",
+			name:    "Specific user issues",
+			args: map[string]interface{}{
+				"user":  USER_ID,
+				"limit": float64(5),
+			},
+		},
+
+		{
+			handler: "get_user_issues",
+			name:    "Sorted by priority",
+			args: map[string]interface{}{
+				"user":           USER_ID,
+				"limit":          float64(5),
+				"sortByPriority": true,
+			},
+		},
+
+		// MyWorkHandler test cases
+		{
+			handler: "my_work",
+			name:    "Combined view",
+			args: map[string]interface{}{
+				"limit": float64(10),
+			},
+		},
+
+		// WaitForChangeHandler test cases
+		{
+			handler: "wait_for_change",
+			name:    "Change mid poll",
+			args: map[string]interface{}{
+				"since":               "2025-07-01T00:00:00Z",
+				"timeoutSeconds":      float64(5),
+				"pollIntervalSeconds": float64(0),
+			},
+		},
+		{
+			handler: "wait_for_change",
+			name:    "Invalid cursor",
+			args: map[string]interface{}{
+				"since": "yesterday",
+			},
+		},
+
+		// CommentCountHandler test cases
+		{
+			handler: "comment_count",
+			name:    "Comment count",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+			},
+		},
+		{
+			handler: "comment_count",
+			name:    "Missing issue param",
+			args:    map[string]interface{}{},
+		},
+
+		// CycleNewIssuesHandler test cases
+		{
+			handler: "cycle_new_issues",
+			name:    "New in cycle",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+			},
+		},
+
+		// GetIssueAncestorsHandler test cases
+		{
+			handler: "get_issue_ancestors",
+			name:    "Three level hierarchy",
+			args: map[string]interface{}{
+				"issue": "TEST-12",
+			},
+		},
+		{
+			handler: "get_issue_ancestors",
+			name:    "Missing issue param",
+			args:    map[string]interface{}{},
+		},
+
+		// GetDraftsHandler test cases
+		{
+			handler: "get_drafts",
+			name:    "Viewer drafts",
+			args:    map[string]interface{}{},
+		},
+
+		// GetProjectIssuesHandler test cases
+		{
+			handler: "get_project_issues",
+			name:    "Project issues",
+			args: map[string]interface{}{
+				"project": "01bff2dd-ab7f-4464-b425-97073862013f",
+			},
+		},
+		{
+			handler: "get_project_issues",
+			name:    "Missing project param",
+			args:    map[string]interface{}{},
+		},
+
+		// ListMilestonesHandler test cases
+		{
+			handler: "list_milestones",
+			name:    "Project milestones",
+			args: map[string]interface{}{
+				"project": "01bff2dd-ab7f-4464-b425-97073862013f",
+			},
+		},
+		{
+			handler: "list_milestones",
+			name:    "Missing project",
+			args:    map[string]interface{}{},
+		},
+
+		// ListProjectsHandler test cases
+		{
+			handler: "list_projects",
+			name:    "List projects",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "list_projects",
+			name:    "By state",
+			args: map[string]interface{}{
+				"state": "started",
+				"limit": float64(5),
+			},
+		},
+
+		// ResolveURLHandler test cases
+		{
+			handler: "resolve_url",
+			name:    "Issue URL",
+			args: map[string]interface{}{
+				"url": "https://linear.app/linear-mcp-go-test/issue/TEST-10/test-issue",
+			},
+		},
+		{
+			handler: "resolve_url",
+			name:    "Project URL",
+			args: map[string]interface{}{
+				"url": "https://linear.app/linear-mcp-go-test/project/mcp-tool-investigation-ae44897e42a7",
+			},
+		},
+		{
+			handler: "resolve_url",
+			name:    "Unsupported URL",
+			args: map[string]interface{}{
+				"url": "https://linear.app/linear-mcp-go-test/document/some-document-abc123",
+			},
+		},
+		{
+			handler: "resolve_url",
+			name:    "Not a Linear URL",
+			args: map[string]interface{}{
+				"url": "https://example.com/foo",
+			},
+		},
+
+		// APIMetricsHandler test cases
+		{
+			handler: "api_metrics",
+			name:    "Metrics",
+			args:    map[string]interface{}{},
+		},
+
+		// GetIssueHandler test cases
+		{
+			handler: "get_issue",
+			name:    "Valid issue",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+			},
+		},
+		{
+			handler: "get_issue",
+			name:    "With relations",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+			},
+		},
+		{
+			handler: "get_issue",
+			name:    "Get comment issue",
+			args: map[string]interface{}{
+				"issue": COMMENT_ISSUE_ID,
+			},
+		},
+		{
+			handler: "get_issue",
+			name:    "With cycle history",
+			args: map[string]interface{}{
+				"issue":               ISSUE_ID,
+				"includeCycleHistory": true,
+			},
+		},
+		{
+			handler: "get_issue",
+			name:    "JSON format",
+			args: map[string]interface{}{
+				"issue":  ISSUE_ID,
+				"format": "json",
+			},
+		},
+		{
+			handler: "get_issue",
+			name:    "Unknown format",
+			args: map[string]interface{}{
+				"issue":  ISSUE_ID,
+				"format": "yaml",
+			},
+		},
+		{
+			handler: "get_issue",
+			name:    "Missing issue",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "get_issue",
+			name:    "Missing issueId",
+			args: map[string]interface{}{
+				"issue": "NONEXISTENT-123",
+			},
+		},
+
+		// GetIssueCommentsHandler test cases
+		{
+			handler: "get_issue_comments",
+			name:    "Valid issue",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+			},
+		},
+		{
+			handler: "get_issue_comments",
+			name:    "Missing issue",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "get_issue_comments",
+			name:    "Invalid issue",
+			args: map[string]interface{}{
+				"issue": "NONEXISTENT-123",
+			},
+		},
+		{
+			handler: "get_issue_comments",
+			name:    "With limit",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+				"limit": float64(3),
+			},
+		},
+		{
+			handler: "get_issue_comments",
+			name:    "With_thread_parameter",
+			args: map[string]interface{}{
+				"issue":  ISSUE_ID,
+				"thread": "ae3d62d6-3f40-4990-867b-5c97dd265a40", // ID of a comment to get replies for
+			},
+		},
+		{
+			handler: "get_issue_comments",
+			name:    "Thread_with_pagination",
+			args: map[string]interface{}{
+				"issue":  ISSUE_ID,
+				"thread": "ae3d62d6-3f40-4990-867b-5c97dd265a40", // ID of a comment to get replies for
+				"limit":  float64(2),
+			},
+		},
+
+		// ArchiveIssueHandler / UnarchiveIssueHandler test cases
+		{
+			handler: "archive_issue",
+			name:    "Valid archive",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+			},
+			write: true,
+		},
+		{
+			handler: "unarchive_issue",
+			name:    "Valid unarchive",
+			args: map[string]interface{}{
+				"issue": ISSUE_ID,
+			},
+			write: true,
+		},
+
+		// CreateIssueRelationHandler test cases
+		{
+			handler: "create_issue_relation",
+			name:    "Create blocks relation",
+			args: map[string]interface{}{
+				"issue":        "TEST-10",
+				"relatedIssue": "TEST-11",
+				"type":         "blocks",
+			},
+			write: true,
+		},
+		{
+			handler: "create_issue_relation",
+			name:    "Invalid relation type",
+			args: map[string]interface{}{
+				"issue":        "TEST-10",
+				"relatedIssue": "TEST-11",
+				"type":         "causes",
+			},
+			write: true,
+		},
+		{
+			handler: "delete_issue_relation",
+			name:    "Valid delete",
+			args: map[string]interface{}{
+				"relation": "5e1f2a3b-4c5d-4e6f-8a9b-0c1d2e3f4a5b",
+				"confirm":  true,
+			},
+			write: true,
+		},
+		{
+			handler: "delete_issue_relation",
+			name:    "Missing confirmation",
+			args: map[string]interface{}{
+				"relation": "5e1f2a3b-4c5d-4e6f-8a9b-0c1d2e3f4a5b",
+			},
+			write: true,
+		},
+		{
+			handler: "delete_issue_relation",
+			name:    "Invalid relation UUID",
+			args: map[string]interface{}{
+				"relation": "TEST-10",
+			},
+			write: true,
+		},
+
+		// AddAttachmentHandler test cases
+		{
+			handler: "add_attachment",
+			name:    "Valid attachment",
+			args: map[string]interface{}{
+				"issue":    "TEST-10",
+				"url":      "https://ci.example.com/runs/4211",
+				"title":    "CI run #4211",
+				"subtitle": "main branch",
+			},
+			write: true,
+		},
+		{
+			handler: "add_attachment",
+			name:    "Missing GitHub integration",
+			args: map[string]interface{}{
+				"issue": "TEST-10",
+				"url":   "https://github.com/acme/widgets/pull/42",
 			},
 			write: true,
 		},
 		{
-			handler: "create_issue",
-			name:    "Create sub issue",
+			handler: "add_attachment",
+			name:    "Invalid url",
 			args: map[string]interface{}{
-				"title":       "Sub Issue",
-				"team":        TEAM_ID,
-				"parentIssue": "1c2de93f-4321-4015-bfde-ee893ef7976f", // UUID for TEST-10
+				"issue": "TEST-10",
+				"url":   "not-a-url",
 			},
 			write: true,
 		},
 		{
-			handler: "create_issue",
-			name:    "Create sub issue from identifier",
+			handler: "delete_attachment",
+			name:    "Valid attachment delete",
 			args: map[string]interface{}{
-				"title":       "Sub Issue",
-				"team":        TEAM_ID,
-				"parentIssue": "TEST-10",
+				"attachment": "6c7d8e9f-0a1b-4c2d-8e3f-4a5b6c7d8e9f",
+				"confirm":    true,
 			},
 			write: true,
 		},
 		{
-			handler: "create_issue",
-			name:    "Create issue with labels",
+			handler: "delete_attachment",
+			name:    "Missing confirmation",
 			args: map[string]interface{}{
-				"title":  "Issue with Labels",
-				"team":   TEAM_ID,
-				"labels": "team label 1",
+				"attachment": "6c7d8e9f-0a1b-4c2d-8e3f-4a5b6c7d8e9f",
 			},
 			write: true,
 		},
 		{
-			handler: "create_issue",
-			name:    "Create sub issue with labels",
+			handler: "delete_attachment",
+			name:    "Invalid attachment UUID",
 			args: map[string]interface{}{
-				"title":       "Sub Issue with Labels",
-				"team":        TEAM_ID,
-				"parentIssue": "1c2de93f-4321-4015-bfde-ee893ef7976f", // UUID for TEST-10
-				"labels":      "ws-label 2,Feature",
+				"attachment": "not-a-uuid",
 			},
 			write: true,
 		},
+
+		// ResolveIssuesHandler test cases
 		{
-			handler: "create_issue",
-			name:    "Missing title",
+			handler: "resolve_issues",
+			name:    "Batch resolve",
 			args: map[string]interface{}{
-				"team": TEAM_ID,
+				"issues": "TEST-10, deadbeef-0000-4000-8000-000000000000, TEST-99",
 			},
 		},
 		{
-			handler: "create_issue",
-			name:    "Missing team",
+			handler: "resolve_issues",
+			name:    "Empty list",
 			args: map[string]interface{}{
-				"title": "Test Issue",
+				"issues": " , ",
 			},
 		},
+
+		// GetViewerHandler test cases
 		{
-			handler: "create_issue",
-			name:    "Invalid team",
+			handler: "get_viewer",
+			name:    "Viewer",
+			args:    map[string]interface{}{},
+		},
+
+		// ListUsersHandler test cases
+		{
+			handler: "list_users",
+			name:    "All users",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "list_users",
+			name:    "Filtered by query",
 			args: map[string]interface{}{
-				"title": "Test Issue",
-				"team":  "NonExistentTeam",
+				"query": "alex",
 			},
 		},
 
-		// UpdateIssueHandler test cases
+		// AssignIssueHandler test cases
 		{
-			handler: "update_issue",
-			name:    "Valid update",
+			handler: "assign_issue",
+			name:    "Assign by email",
 			args: map[string]interface{}{
-				"issue": ISSUE_ID,
-				"title": "Updated Test Issue",
+				"issue":    ISSUE_ID,
+				"assignee": "gero@example.com",
 			},
 			write: true,
 		},
 		{
-			handler: "update_issue",
-			name:    "Missing id",
+			handler: "assign_issue",
+			name:    "Clear assignee",
 			args: map[string]interface{}{
-				"title": "Updated Test Issue",
+				"issue":    ISSUE_ID,
+				"assignee": "",
 			},
+			write: true,
 		},
-
-		// SearchIssuesHandler test cases
 		{
-			handler: "search_issues",
-			name:    "Search by team",
+			handler: "assign_issue",
+			name:    "Ambiguous name",
 			args: map[string]interface{}{
-				"team":  TEAM_ID,
-				"limit": float64(5),
+				"issue":    ISSUE_ID,
+				"assignee": "Alex",
 			},
+			write: true,
 		},
 		{
-			handler: "search_issues",
-			name:    "Search by query",
+			handler: "assign_issue",
+			name:    "Assign by display name",
 			args: map[string]interface{}{
-				"query": "test",
-				"limit": float64(5),
+				"issue":    ISSUE_ID,
+				"assignee": "@alexp",
 			},
+			write: true,
 		},
 
-		// GetUserIssuesHandler test cases
+		// ResolveCommentHandler test cases
 		{
-			handler: "get_user_issues",
-			name:    "Current user issues",
+			handler: "resolve_comment",
+			name:    "Resolve",
 			args: map[string]interface{}{
-				"limit": float64(5),
+				"comment": "ae3d62d6-3f40-4990-867b-5c97dd265a40",
 			},
+			write: true,
 		},
 		{
-			handler: "get_user_issues",
-			name:    "Specific user issues",
+			handler: "resolve_comment",
+			name:    "Unresolve",
 			args: map[string]interface{}{
-				"user":  USER_ID,
-				"limit": float64(5),
+				"comment":  "ae3d62d6-3f40-4990-867b-5c97dd265a40",
+				"resolved": false,
 			},
+			write: true,
 		},
 
-		// GetIssueHandler test cases
+		// CompareIssuesHandler test cases
 		{
-			handler: "get_issue",
-			name:    "Valid issue",
+			handler: "compare_issues",
+			name:    "Compare two issues",
 			args: map[string]interface{}{
-				"issue": ISSUE_ID,
+				"issue1": "1c2de93f-4321-4015-bfde-ee893ef7976f",
+				"issue2": "9f8e7d6c-5b4a-4392-8171-605f4e3d2c1b",
 			},
 		},
 		{
-			handler: "get_issue",
-			name:    "Get comment issue",
+			handler: "compare_issues",
+			name:    "Missing second issue",
 			args: map[string]interface{}{
-				"issue": COMMENT_ISSUE_ID,
+				"issue1": ISSUE_ID,
 			},
 		},
+
+		// CompletedSinceHandler test cases
 		{
-			handler: "get_issue",
-			name:    "Missing issue",
-			args:    map[string]interface{}{},
+			handler: "completed_since",
+			name:    "Completed issues",
+			args: map[string]interface{}{
+				"team":  TEAM_ID,
+				"since": "2025-07-01",
+			},
 		},
 		{
-			handler: "get_issue",
-			name:    "Missing issueId",
+			handler: "completed_since",
+			name:    "Invalid date",
 			args: map[string]interface{}{
-				"issue": "NONEXISTENT-123",
+				"team":  TEAM_ID,
+				"since": "last tuesday",
 			},
 		},
 
-		// GetIssueCommentsHandler test cases
+		// StaleIssuesHandler test cases
 		{
-			handler: "get_issue_comments",
-			name:    "Valid issue",
+			handler: "stale_issues",
+			name:    "Stale issues",
 			args: map[string]interface{}{
-				"issue": ISSUE_ID,
+				"team":      TEAM_ID,
+				"staleDays": float64(30),
+				"asOf":      "2025-07-01",
 			},
 		},
 		{
-			handler: "get_issue_comments",
-			name:    "Missing issue",
-			args:    map[string]interface{}{},
-		},
-		{
-			handler: "get_issue_comments",
-			name:    "Invalid issue",
+			handler: "stale_issues",
+			name:    "Invalid staleDays",
 			args: map[string]interface{}{
-				"issue": "NONEXISTENT-123",
+				"team":      TEAM_ID,
+				"staleDays": float64(0),
 			},
 		},
+
+		// GetIssueDocumentHandler test cases
 		{
-			handler: "get_issue_comments",
-			name:    "With limit",
+			handler: "get_issue_document",
+			name:    "Multi page comments",
 			args: map[string]interface{}{
 				"issue": ISSUE_ID,
-				"limit": float64(3),
 			},
 		},
 		{
-			handler: "get_issue_comments",
-			name:    "With_thread_parameter",
+			handler: "get_issue_document",
+			name:    "Missing issue",
+			args:    map[string]interface{}{},
+		},
+
+		// GetCommentThreadHandler test cases
+		{
+			handler: "get_comment_thread",
+			name:    "Full thread",
 			args: map[string]interface{}{
-				"issue":  ISSUE_ID,
-				"thread": "ae3d62d6-3f40-4990-867b-5c97dd265a40", // ID of a comment to get replies for
+				"comment": "ae3d62d6-3f40-4990-867b-5c97dd265a40",
 			},
 		},
 		{
-			handler: "get_issue_comments",
-			name:    "Thread_with_pagination",
+			handler: "get_comment_thread",
+			name:    "Invalid comment id",
 			args: map[string]interface{}{
-				"issue":  ISSUE_ID,
-				"thread": "ae3d62d6-3f40-4990-867b-5c97dd265a40", // ID of a comment to get replies for
-				"limit":  float64(2),
+				"comment": "not-a-uuid",
 			},
 		},
 
@@ -291,6 +1249,26 @@ func TestHandlers(t *testing.T) {
 				"issue": ISSUE_ID,
 			},
 		},
+		// BroadcastCommentHandler test cases
+		{
+			handler: "broadcast_comment",
+			name:    "Broadcast comment",
+			write:   true,
+			args: map[string]interface{}{
+				"issues": "TEST-10, TEST-11",
+				"body":   "Heads up: the API migration lands tomorrow.",
+			},
+		},
+		{
+			handler: "broadcast_comment",
+			name:    "Dry run",
+			write:   true,
+			args: map[string]interface{}{
+				"issues": "TEST-10, TEST-11",
+				"body":   "Heads up: the API migration lands tomorrow.",
+				"dryRun": true,
+			},
+		},
 		// UpdateCommentHandler test cases
 		{
 			handler: "update_comment",
@@ -301,6 +1279,72 @@ func TestHandlers(t *testing.T) {
 				"body":    "Updated comment text",
 			},
 		},
+		{
+			handler: "project_timeline",
+			name:    "Project timeline",
+			args: map[string]interface{}{
+				"project": "01bff2dd-ab7f-4464-b425-97073862013f",
+			},
+		},
+		{
+			handler: "project_timeline",
+			name:    "Missing project param",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "get_team",
+			name:    "Valid team",
+			args: map[string]interface{}{
+				"team": "TEST",
+			},
+		},
+		{
+			handler: "get_team",
+			name:    "Missing team param",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "board",
+			name:    "Team board",
+			args: map[string]interface{}{
+				"team": TEAM_ID,
+			},
+		},
+		{
+			handler: "board",
+			name:    "Missing team param",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "mentions",
+			name:    "Mentions of user",
+			args: map[string]interface{}{
+				"user":  "alexp",
+				"limit": float64(5),
+			},
+		},
+		{
+			handler: "mentions",
+			name:    "Missing user param",
+			args:    map[string]interface{}{},
+		},
+		{
+			handler: "comment_to_issue",
+			name:    "Promote comment",
+			write:   true,
+			args: map[string]interface{}{
+				"comment": "comment-ae3d62d6",
+				"team":    "TEST",
+			},
+		},
+		{
+			handler: "comment_to_issue",
+			name:    "Missing comment param",
+			write:   true,
+			args: map[string]interface{}{
+				"team": "TEST",
+			},
+		},
 		{
 			handler: "update_comment",
 			name:    "Valid comment update with shorthand",
@@ -369,6 +1413,13 @@ func TestHandlers(t *testing.T) {
 				"project": "mcp-tool-investigation-ae44897e42a7",
 			},
 		},
+		{
+			handler: "get_project",
+			name:    "Multiple teams",
+			args: map[string]interface{}{
+				"project": "d4f8b2c6-0a1e-4f3b-9c77-5e21a8d4f0b3",
+			},
+		},
 		{
 			handler: "get_project",
 			name:    "By name",
@@ -428,6 +1479,35 @@ func TestHandlers(t *testing.T) {
 			},
 			write: true,
 		},
+		{
+			handler: "create_project",
+			name:    "Team by key",
+			args: map[string]interface{}{
+				"name":    "Key Team Project",
+				"teamIds": TEAM_KEY,
+			},
+			write: true,
+		},
+		{
+			handler: "create_project",
+			name:    "Lead by email",
+			args: map[string]interface{}{
+				"name":    "Lead Test Project",
+				"teamIds": TEAM_ID,
+				"lead":    "gero@example.com",
+			},
+			write: true,
+		},
+		{
+			handler: "create_project",
+			name:    "Invalid icon",
+			args: map[string]interface{}{
+				"name":    "Iconic Project",
+				"teamIds": TEAM_ID,
+				"icon":    " ",
+			},
+			write: true,
+		},
 		{
 			handler: "create_project",
 			name:    "Missing name",
@@ -465,6 +1545,15 @@ func TestHandlers(t *testing.T) {
 			},
 			write: true,
 		},
+		{
+			handler: "update_project",
+			name:    "Lead by email",
+			args: map[string]interface{}{
+				"project": UPDATE_PROJECT_ID,
+				"lead":    "gero@example.com",
+			},
+			write: true,
+		},
 		{
 			handler: "update_project",
 			name:    "Non-existent project",
@@ -474,6 +1563,45 @@ func TestHandlers(t *testing.T) {
 			},
 			write: true,
 		},
+
+		// AddProjectMemberHandler test cases
+		{
+			handler: "add_project_member",
+			name:    "Valid add",
+			args: map[string]interface{}{
+				"project": UPDATE_PROJECT_ID,
+				"user":    USER_ID,
+			},
+			write: true,
+		},
+		{
+			handler: "add_project_member",
+			name:    "Missing user",
+			args: map[string]interface{}{
+				"project": UPDATE_PROJECT_ID,
+			},
+			write: true,
+		},
+
+		// RemoveProjectMemberHandler test cases
+		{
+			handler: "remove_project_member",
+			name:    "Valid remove",
+			args: map[string]interface{}{
+				"project": UPDATE_PROJECT_ID,
+				"user":    USER_ID,
+			},
+			write: true,
+		},
+		{
+			handler: "remove_project_member",
+			name:    "Not a member",
+			args: map[string]interface{}{
+				"project": UPDATE_PROJECT_ID,
+				"user":    "ffffffff-aaaa-4bbb-8ccc-111111111111",
+			},
+			write: true,
+		},
 		{
 			handler: "update_project",
 			name:    "Update only description",
@@ -586,6 +1714,21 @@ func TestHandlers(t *testing.T) {
 				"initiative": "non-existent-name",
 			},
 		},
+		// GetInitiativeUpdatesHandler test cases
+		{
+			handler: "get_initiative_updates",
+			name:    "Initiative updates",
+			args: map[string]interface{}{
+				"initiative": INITIATIVE_ID,
+			},
+		},
+		{
+			handler: "get_initiative_updates",
+			name:    "No updates",
+			args: map[string]interface{}{
+				"initiative": INITIATIVE_ID,
+			},
+		},
 		// CreateInitiativeHandler test cases
 		{
 			handler: "create_initiative",
@@ -604,6 +1747,24 @@ func TestHandlers(t *testing.T) {
 			},
 			write: true,
 		},
+		{
+			handler: "create_initiative",
+			name:    "With icon",
+			args: map[string]interface{}{
+				"name": "Iconic Initiative",
+				"icon": "🚀",
+			},
+			write: true,
+		},
+		{
+			handler: "create_initiative",
+			name:    "Invalid icon",
+			args: map[string]interface{}{
+				"name": "Iconic Initiative",
+				"icon": "not an icon",
+			},
+			write: true,
+		},
 		{
 			handler: "create_initiative",
 			name:    "Missing name",
@@ -651,20 +1812,112 @@ func TestHandlers(t *testing.T) {
 			switch tt.handler {
 			case "get_teams":
 				handler = tools.GetTeamsHandler(client)
+			case "label_usage":
+				handler = tools.LabelUsageHandler(client)
+			case "watch_project":
+				handler = tools.WatchProjectHandler(client)
+			case "create_label":
+				handler = tools.CreateLabelHandler(client)
+			case "delete_label":
+				handler = tools.DeleteLabelHandler(client)
+			case "list_cycles":
+				handler = tools.ListCyclesHandler(client)
+			case "team_workload":
+				handler = tools.TeamWorkloadHandler(client)
+			case "get_labels":
+				handler = tools.GetLabelsHandler(client)
+			case "get_team_status_options":
+				handler = tools.GetTeamStatusOptionsHandler(client)
+			case "issues_by_label":
+				handler = tools.IssuesByLabelHandler(client)
+			case "my_work":
+				handler = tools.MyWorkHandler(client)
+			case "wait_for_change":
+				handler = tools.WaitForChangeHandler(client)
+			case "api_metrics":
+				handler = tools.APIMetricsHandler(client)
+			case "resolve_url":
+				handler = tools.ResolveURLHandler(client)
+			case "list_projects":
+				handler = tools.ListProjectsHandler(client)
+			case "list_milestones":
+				handler = tools.ListMilestonesHandler(client)
+			case "get_project_issues":
+				handler = tools.GetProjectIssuesHandler(client)
+			case "get_drafts":
+				handler = tools.GetDraftsHandler(client)
+			case "comment_count":
+				handler = tools.CommentCountHandler(client)
+			case "get_issue_ancestors":
+				handler = tools.GetIssueAncestorsHandler(client)
+			case "cycle_new_issues":
+				handler = tools.CycleNewIssuesHandler(client)
 			case "create_issue":
 				handler = tools.CreateIssueHandler(client)
 			case "update_issue":
 				handler = tools.UpdateIssueHandler(client)
+			case "delete_issue":
+				handler = tools.DeleteIssueHandler(client)
+			case "set_issue_labels":
+				handler = tools.SetIssueLabelsHandler(client)
+			case "bulk_label":
+				handler = tools.BulkLabelHandler(client)
+			case "bulk_move_to_project":
+				handler = tools.BulkMoveToProjectHandler(client)
 			case "search_issues":
 				handler = tools.SearchIssuesHandler(client)
 			case "get_user_issues":
 				handler = tools.GetUserIssuesHandler(client)
 			case "get_issue":
 				handler = tools.GetIssueHandler(client)
+			case "archive_issue":
+				handler = tools.ArchiveIssueHandler(client)
+			case "create_issue_relation":
+				handler = tools.CreateIssueRelationHandler(client)
+			case "delete_issue_relation":
+				handler = tools.DeleteIssueRelationHandler(client)
+			case "add_attachment":
+				handler = tools.AddAttachmentHandler(client)
+			case "delete_attachment":
+				handler = tools.DeleteAttachmentHandler(client)
+			case "unarchive_issue":
+				handler = tools.UnarchiveIssueHandler(client)
+			case "resolve_issues":
+				handler = tools.ResolveIssuesHandler(client)
+			case "assign_issue":
+				handler = tools.AssignIssueHandler(client)
+			case "list_users":
+				handler = tools.ListUsersHandler(client)
+			case "get_viewer":
+				handler = tools.GetViewerHandler(client)
+			case "resolve_comment":
+				handler = tools.ResolveCommentHandler(client)
+			case "compare_issues":
+				handler = tools.CompareIssuesHandler(client)
+			case "stale_issues":
+				handler = tools.StaleIssuesHandler(client)
+			case "completed_since":
+				handler = tools.CompletedSinceHandler(client)
+			case "get_issue_document":
+				handler = tools.GetIssueDocumentHandler(client)
+			case "get_comment_thread":
+				handler = tools.GetCommentThreadHandler(client)
 			case "get_issue_comments":
 				handler = tools.GetIssueCommentsHandler(client)
 			case "add_comment":
 				handler = tools.AddCommentHandler(client)
+			case "broadcast_comment":
+				handler = tools.BroadcastCommentHandler(client)
+			case "project_timeline":
+				handler = tools.ProjectTimelineHandler(client)
+			case "get_team":
+				handler = tools.GetTeamHandler(client)
+			case "board":
+				handler = tools.BoardHandler(client)
+			case "mentions":
+				handler = tools.MentionsHandler(client)
+			case "comment_to_issue":
+				handler = tools.CommentToIssueHandler(client)
 			case "update_comment":
 				handler = tools.UpdateCommentHandler(client)
 			case "get_project":
@@ -675,6 +1928,10 @@ func TestHandlers(t *testing.T) {
 				handler = tools.CreateProjectHandler(client)
 			case "update_project":
 				handler = tools.UpdateProjectHandler(client)
+			case "add_project_member":
+				handler = tools.AddProjectMemberHandler(client)
+			case "remove_project_member":
+				handler = tools.RemoveProjectMemberHandler(client)
 			case "get_milestone":
 				handler = tools.GetMilestoneHandler(client)
 			case "create_milestone":
@@ -683,6 +1940,8 @@ func TestHandlers(t *testing.T) {
 				handler = tools.UpdateMilestoneHandler(client)
 			case "get_initiative":
 				handler = tools.GetInitiativeHandler(client)
+			case "get_initiative_updates":
+				handler = tools.GetInitiativeUpdatesHandler(client)
 			case "create_initiative":
 				handler = tools.CreateInitiativeHandler(client)
 			case "update_initiative":
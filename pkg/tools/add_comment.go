@@ -49,7 +49,7 @@ func AddCommentHandler(linearClient *linear.LinearClient) func(ctx context.Conte
 			ParentID:     parentID,
 		}
 
-		comment, issue, err := linearClient.AddComment(input)
+		comment, issue, err := linearClient.AddComment(ctx, input)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to add comment: %v", err)}}}, nil
 		}
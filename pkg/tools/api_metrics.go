@@ -0,0 +1,30 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// APIMetricsTool is the tool definition for reporting API usage metrics
+var APIMetricsTool = mcp.NewTool("linear_api_metrics",
+	mcp.WithDescription("Reports Linear API usage metrics of this server, useful to diagnose rate limiting and throttling."),
+)
+
+// APIMetricsHandler handles the linear_api_metrics tool
+func APIMetricsHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		metrics := linearClient.GetMetrics()
+
+		resultText := "Linear API metrics:\n"
+		resultText += fmt.Sprintf("Requests in last hour: %d\n", metrics.RequestsInLastHour)
+		resultText += fmt.Sprintf("Remaining requests: %d\n", metrics.RemainingRequests)
+		resultText += fmt.Sprintf("Average request time: %s\n", metrics.AverageRequestTime)
+		resultText += fmt.Sprintf("Queue length: %d\n", metrics.QueueLength)
+		resultText += fmt.Sprintf("Last request time: %s\n", metrics.LastRequestTime)
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
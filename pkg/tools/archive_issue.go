@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ArchiveIssueTool is the tool definition for archiving issues
+var ArchiveIssueTool = mcp.NewTool("linear_archive_issue",
+	mcp.WithDescription("Archives a Linear issue without deleting it."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("Issue ID or identifier (e.g., 'TEAM-123') to archive")),
+)
+
+// UnarchiveIssueTool is the tool definition for unarchiving issues
+var UnarchiveIssueTool = mcp.NewTool("linear_unarchive_issue",
+	mcp.WithDescription("Restores a previously archived Linear issue."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("Issue ID or identifier (e.g., 'TEAM-123') to unarchive")),
+)
+
+// ArchiveIssueHandler handles the linear_archive_issue tool
+func ArchiveIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve issue identifier to a UUID
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		// Archive the issue
+		if err := linearClient.ArchiveIssue(issueID); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to archive issue: %v", err)}}}, nil
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Issue %s is now archived.", issueIdentifier)}}}, nil
+	}
+}
+
+// UnarchiveIssueHandler handles the linear_unarchive_issue tool
+func UnarchiveIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve issue identifier to a UUID
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		// Unarchive the issue
+		if err := linearClient.UnarchiveIssue(issueID); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to unarchive issue: %v", err)}}}, nil
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Issue %s is no longer archived.", issueIdentifier)}}}, nil
+	}
+}
@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// AssignIssueTool is the tool definition for assigning issues
+var AssignIssueTool = mcp.NewTool("linear_assign_issue",
+	mcp.WithDescription("Sets or clears the assignee of an issue."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("Issue ID or identifier (e.g., 'TEAM-123')")),
+	mcp.WithString("assignee", mcp.Required(), mcp.Description("User identifier (UUID, name, display name, or email); pass an empty string to clear the assignee")),
+)
+
+// AssignIssueHandler handles the linear_assign_issue tool
+func AssignIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		assignee, err := request.RequireString("assignee")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve issue identifier to a UUID
+		id, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		// Resolve the assignee; an empty identifier clears the assignee
+		assigneeID := ""
+		if assignee != "" {
+			assigneeID, err = resolveUserIdentifier(linearClient.NewResolver(), assignee)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve assignee: %v", err)}}}, nil
+			}
+		}
+
+		// Update the issue
+		issue, err := linearClient.UpdateIssue(linear.UpdateIssueInput{
+			ID:         id,
+			AssigneeID: &assigneeID,
+		})
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to assign issue: %v", err)}}}, nil
+		}
+
+		// Return the result
+		var resultText string
+		if assigneeID == "" {
+			resultText = fmt.Sprintf("Cleared assignee of %s", formatIssueIdentifier(issue))
+		} else {
+			resultText = fmt.Sprintf("Assigned %s to user %s", formatIssueIdentifier(issue), assigneeID)
+		}
+		resultText += fmt.Sprintf("\nURL: %s", issue.URL)
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
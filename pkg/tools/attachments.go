@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// AddAttachmentTool is the tool definition for adding URL attachments to issues
+var AddAttachmentTool = mcp.NewTool("linear_add_attachment",
+	mcp.WithDescription("Attaches an external URL (e.g., a CI run or a document) to a Linear issue."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("ID or identifier (e.g., 'TEAM-123') of the issue to attach to")),
+	mcp.WithString("url", mcp.Required(), mcp.Description("Absolute URL to attach")),
+	mcp.WithString("title", mcp.Description("Title of the attachment (defaults to the URL)")),
+	mcp.WithString("subtitle", mcp.Description("Optional subtitle shown below the title")),
+)
+
+// DeleteAttachmentTool is the tool definition for removing attachments from issues
+var DeleteAttachmentTool = mcp.NewTool("linear_delete_attachment",
+	mcp.WithDescription("Removes an attachment from a Linear issue. Requires confirm=true."),
+	mcp.WithString("attachment", mcp.Required(), mcp.Description("UUID of the attachment to delete (shown in the linear_get_issue output)")),
+	mcp.WithBoolean("confirm", mcp.Required(), mcp.Description("Must be true to actually delete the attachment. Guards against accidental deletes.")),
+)
+
+// AddAttachmentHandler handles the linear_add_attachment tool
+func AddAttachmentHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		attachmentURL, err := request.RequireString("url")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Validate that the URL is a well-formed absolute URL
+		parsed, err := url.Parse(attachmentURL)
+		if err != nil || !parsed.IsAbs() || parsed.Host == "" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid url '%s': expected an absolute URL like 'https://example.com/page'", attachmentURL)}}}, nil
+		}
+
+		title := request.GetString("title", attachmentURL)
+		subtitle := request.GetString("subtitle", "")
+
+		// Resolve issue identifier to a UUID
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		// Create the attachment
+		attachment, err := linearClient.CreateAttachment(issueID, title, attachmentURL, subtitle)
+		if err != nil {
+			errText := fmt.Sprintf("Failed to create attachment: %v", err)
+			// A missing GitHub integration is a common setup gap behind opaque
+			// attachment failures; call it out explicitly for GitHub URLs
+			if host := strings.TrimPrefix(parsed.Host, "www."); strings.EqualFold(host, "github.com") {
+				if has, checkErr := linearClient.HasIntegration("github"); checkErr == nil && !has {
+					errText += ". The workspace has no GitHub integration configured; connect GitHub in Linear's settings to link pull requests."
+				}
+			}
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: errText}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Attached %s to issue %s (UUID: %s)", attachment.URL, issueIdentifier, attachment.ID)
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+// DeleteAttachmentHandler handles the linear_delete_attachment tool
+func DeleteAttachmentHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		attachmentID, err := request.RequireString("attachment")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		if !isValidUUID(attachmentID) {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid attachment UUID '%s'", attachmentID)}}}, nil
+		}
+
+		// Require explicit confirmation before doing anything destructive
+		if refusal := requireConfirmation(request, fmt.Sprintf("delete attachment '%s'", attachmentID)); refusal != nil {
+			return refusal, nil
+		}
+
+		// Delete the attachment
+		if err := linearClient.DeleteAttachment(attachmentID); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to delete attachment: %v", err)}}}, nil
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Deleted attachment %s.", attachmentID)}}}, nil
+	}
+}
@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// BoardTool is the tool definition for the board view of a team
+var BoardTool = mcp.NewTool("linear_board",
+	mcp.WithDescription("Retrieves a team's issues grouped by workflow state, in board order."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (key, UUID or name)")),
+	mcp.WithNumber("limit", mcp.Description("Maximum number of issues to place on the board (default: 50)")),
+)
+
+// stateTypeRank orders workflow state types the way a board lays out its
+// columns; unknown types sort last.
+var stateTypeRank = map[string]int{
+	"triage":    0,
+	"backlog":   1,
+	"unstarted": 2,
+	"started":   3,
+	"completed": 4,
+	"canceled":  5,
+}
+
+// BoardHandler handles the linear_board tool
+func BoardHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		limit := request.GetInt("limit", 50)
+
+		// Resolve team identifier to a team ID
+		resolver := linearClient.NewResolver()
+		teamID, err := resolveTeamIdentifier(resolver, team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Get the board columns
+		states, err := linearClient.GetWorkflowStates(teamID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get workflow states: %v", err)}}}, nil
+		}
+
+		// Order the columns by state type, then by position within a type
+		sort.SliceStable(states, func(i, j int) bool {
+			rankI, ok := stateTypeRank[states[i].Type]
+			if !ok {
+				rankI = len(stateTypeRank)
+			}
+			rankJ, ok := stateTypeRank[states[j].Type]
+			if !ok {
+				rankJ = len(stateTypeRank)
+			}
+			if rankI != rankJ {
+				return rankI < rankJ
+			}
+			return states[i].Position < states[j].Position
+		})
+
+		// Get the team's issues
+		results, err := linearClient.SearchIssues(ctx, linear.SearchIssuesInput{
+			TeamID: teamID,
+			Limit:  limit,
+		})
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issues: %v", err)}}}, nil
+		}
+		issues := results.Nodes
+
+		// Group the issues by state name
+		grouped := map[string][]linear.LinearIssueResponse{}
+		for _, issue := range issues {
+			stateName := issue.StateName
+			if stateName == "" {
+				stateName = issue.Status
+			}
+			grouped[stateName] = append(grouped[stateName], issue)
+		}
+
+		// Format the result, one section per board column
+		resultText := fmt.Sprintf("Board for team '%s' (%d issues):\n", team, len(issues))
+		for _, state := range states {
+			columnIssues := grouped[state.Name]
+			delete(grouped, state.Name)
+
+			resultText += fmt.Sprintf("\n%s [%s] (%d):\n", state.Name, state.Type, len(columnIssues))
+			for _, issue := range columnIssues {
+				resultText += fmt.Sprintf("- %s: %s", issue.Identifier, issue.Title)
+				if issue.AssigneeName != "" {
+					resultText += fmt.Sprintf(" (%s)", issue.AssigneeName)
+				}
+				resultText += "\n"
+			}
+		}
+
+		// Issues whose state is not among the team's workflow states
+		if len(grouped) > 0 {
+			leftover := []linear.LinearIssueResponse{}
+			for _, columnIssues := range grouped {
+				leftover = append(leftover, columnIssues...)
+			}
+			sort.Slice(leftover, func(i, j int) bool { return leftover[i].Identifier < leftover[j].Identifier })
+
+			resultText += fmt.Sprintf("\nOther (%d):\n", len(leftover))
+			for _, issue := range leftover {
+				resultText += fmt.Sprintf("- %s: %s\n", issue.Identifier, issue.Title)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// BroadcastCommentTool is the tool definition for posting one comment to many issues
+var BroadcastCommentTool = mcp.NewTool("linear_broadcast_comment",
+	mcp.WithDescription("Posts the same comment to a list of issues, e.g. to notify all affected tickets of an announcement. Use dryRun first to review the affected issues."),
+	mcp.WithString("issues", mcp.Required(), mcp.Description("Comma-separated issue identifiers (UUIDs or 'TEAM-123' style) to post the comment to")),
+	mcp.WithString("body", mcp.Required(), mcp.Description("The comment text to post (supports markdown)")),
+	mcp.WithBoolean("dryRun", mcp.Description("Only list the issues that would be commented on, without posting anything (default: false)")),
+	mcp.WithNumber("maxIssues", mcp.Description("Abort if more than this many issues are given (default: 25)")),
+)
+
+// BroadcastCommentHandler handles the linear_broadcast_comment tool
+func BroadcastCommentHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issuesStr, err := request.RequireString("issues")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		body, err := request.RequireString("body")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		dryRun := request.GetBool("dryRun", false)
+		maxIssues := request.GetInt("maxIssues", 25)
+		if maxIssues < 1 {
+			maxIssues = 1
+		}
+
+		// Collect the issue identifiers
+		var identifiers []string
+		for _, identifier := range strings.Split(issuesStr, ",") {
+			identifier = strings.TrimSpace(identifier)
+			if identifier != "" {
+				identifiers = append(identifiers, identifier)
+			}
+		}
+
+		if len(identifiers) == 0 {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "No issue identifiers given"}}}, nil
+		}
+
+		if len(identifiers) > maxIssues {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Got %d issues, more than the cap of %d; split the broadcast or raise maxIssues", len(identifiers), maxIssues)}}}, nil
+		}
+
+		// Resolve all identifiers up front so a typo fails before anything is posted
+		issueIDs := make([]string, 0, len(identifiers))
+		for _, identifier := range identifiers {
+			issueID, err := resolveIssueIdentifier(linearClient, identifier)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue '%s': %v", identifier, err)}}}, nil
+			}
+			issueIDs = append(issueIDs, issueID)
+		}
+
+		// Dry run: only report what would change
+		if dryRun {
+			resultText := fmt.Sprintf("Dry run: would post the comment to %d issue(s):\n", len(identifiers))
+			for _, identifier := range identifiers {
+				resultText += fmt.Sprintf("- %s\n", identifier)
+			}
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+		}
+
+		// Post the comment to each issue
+		posted := 0
+		var failures []string
+		for i, issueID := range issueIDs {
+			if _, _, err := linearClient.AddComment(ctx, linear.AddCommentInput{IssueID: issueID, Body: body}); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", identifiers[i], err))
+				continue
+			}
+			posted++
+		}
+
+		resultText := fmt.Sprintf("Posted the comment to %d issue(s):\n", posted)
+		for _, identifier := range identifiers {
+			resultText += fmt.Sprintf("- %s\n", identifier)
+		}
+		if len(failures) > 0 {
+			resultText += fmt.Sprintf("\nFailed for %d issue(s):\n", len(failures))
+			for _, failure := range failures {
+				resultText += fmt.Sprintf("- %s\n", failure)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
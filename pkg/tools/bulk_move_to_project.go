@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// BulkMoveToProjectTool is the tool definition for moving all issues matching a search into a project
+var BulkMoveToProjectTool = mcp.NewTool("linear_bulk_move_to_project",
+	mcp.WithDescription("Moves every issue matching a search into a project. Use dryRun first to review the affected issues."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key); scopes the search")),
+	mcp.WithString("project", mcp.Required(), mcp.Description("Target project ID, name or slug")),
+	mcp.WithString("query", mcp.Description("Optional text to search in title and description")),
+	mcp.WithString("status", mcp.Description("Filter by status name (e.g., 'In Progress', 'Done')")),
+	mcp.WithString("assignee", mcp.Description("Filter by assignee identifier (UUID, name, or email)")),
+	mcp.WithBoolean("dryRun", mcp.Description("Only list the issues that would be moved, without changing anything (default: false)")),
+	mcp.WithNumber("maxIssues", mcp.Description("Abort if the search matches more than this many issues (default: 25)")),
+)
+
+// BulkMoveToProjectHandler handles the linear_bulk_move_to_project tool
+func BulkMoveToProjectHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		projectIdentifier, err := request.RequireString("project")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		dryRun := request.GetBool("dryRun", false)
+		maxIssues := request.GetInt("maxIssues", 25)
+		if maxIssues < 1 {
+			maxIssues = 1
+		}
+
+		resolver := linearClient.NewResolver()
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(resolver, team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Resolve the target project
+		project, err := linearClient.GetProject(projectIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve project: %v", err)}}}, nil
+		}
+
+		// Build the search input; fetch one more than the cap to detect overflow
+		input := linear.SearchIssuesInput{
+			TeamID: teamID,
+			Query:  request.GetString("query", ""),
+			Status: request.GetString("status", ""),
+			Limit:  maxIssues + 1,
+		}
+
+		if assignee, err := request.RequireString("assignee"); err == nil && assignee != "" {
+			assigneeID, err := resolveUserIdentifier(resolver, assignee)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve assignee: %v", err)}}}, nil
+			}
+			input.AssigneeID = assigneeID
+		}
+
+		// Search for the issues to move
+		results, err := linearClient.SearchIssues(ctx, input)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to search issues: %v", err)}}}, nil
+		}
+		issues := results.Nodes
+
+		if len(issues) > maxIssues {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Search matches more than %d issues; narrow the search or raise maxIssues", maxIssues)}}}, nil
+		}
+
+		if len(issues) == 0 {
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "No issues match the search; nothing to move.\n"}}}, nil
+		}
+
+		// Dry run: only report what would change
+		if dryRun {
+			resultText := fmt.Sprintf("Dry run: would move %d issue(s) to project '%s':\n", len(issues), project.Name)
+			for _, issue := range issues {
+				resultText += fmt.Sprintf("- %s: %s\n", issue.Identifier, issue.Title)
+			}
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+		}
+
+		// Move the issues one by one
+		moved := 0
+		var failures []string
+		for _, issue := range issues {
+			if _, err := linearClient.UpdateIssue(linear.UpdateIssueInput{ID: issue.ID, ProjectID: project.ID}); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", issue.Identifier, err))
+				continue
+			}
+			moved++
+		}
+
+		resultText := fmt.Sprintf("Moved %d issue(s) to project '%s':\n", moved, project.Name)
+		for _, issue := range issues {
+			resultText += fmt.Sprintf("- %s: %s\n", issue.Identifier, issue.Title)
+		}
+		if len(failures) > 0 {
+			resultText += fmt.Sprintf("\nFailed for %d issue(s):\n", len(failures))
+			for _, failure := range failures {
+				resultText += fmt.Sprintf("- %s\n", failure)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CommentCountTool is the tool definition for counting comments on an issue
+var CommentCountTool = mcp.NewTool("linear_comment_count",
+	mcp.WithDescription("Returns the number of comments on an issue without fetching their bodies. Cheap way to spot issues with lots of discussion."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("ID or identifier (e.g., 'TEAM-123') of the issue")),
+)
+
+// CommentCountHandler handles the linear_comment_count tool
+func CommentCountHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve issue identifier to a UUID
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		count, err := linearClient.GetIssueCommentCount(issueID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to count comments: %v", err)}}}, nil
+		}
+
+		commentStr := "comments"
+		if count == 1 {
+			commentStr = "comment"
+		}
+		resultText := fmt.Sprintf("Issue %s has %d %s.\n", issueIdentifier, count, commentStr)
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CommentToIssueTool is the tool definition for converting a comment into a new issue
+var CommentToIssueTool = mcp.NewTool("linear_comment_to_issue",
+	mcp.WithDescription("Converts a comment into a new issue: the comment body becomes the issue description, and a back-reference comment linking to the source thread is posted on the new issue."),
+	mcp.WithString("comment", mcp.Required(), mcp.Description("Comment identifier (UUID or shorthand like 'comment-53099b37')")),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (key, UUID or name) to create the issue in")),
+	mcp.WithString("title", mcp.Description("Title for the new issue (default: the first line of the comment body)")),
+)
+
+// CommentToIssueHandler handles the linear_comment_to_issue tool
+func CommentToIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		commentIdentifier, err := request.RequireString("comment")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve team identifier to a team ID
+		resolver := linearClient.NewResolver()
+		teamID, err := resolveTeamIdentifier(resolver, team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Fetch the source comment (by UUID or shorthand hash)
+		var comment *linear.Comment
+		if isValidUUID(commentIdentifier) {
+			comment, err = linearClient.GetComment(commentIdentifier)
+		} else {
+			comment, err = linearClient.GetCommentByHash(strings.TrimPrefix(commentIdentifier, "comment-"))
+		}
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get comment: %v", err)}}}, nil
+		}
+
+		// Default the title to the first non-empty line of the comment body
+		title := request.GetString("title", "")
+		if title == "" {
+			for _, line := range strings.Split(comment.Body, "\n") {
+				if trimmed := strings.TrimSpace(line); trimmed != "" {
+					title = trimmed
+					break
+				}
+			}
+		}
+		if title == "" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Comment %s has an empty body; pass an explicit title", formatCommentIdentifier(comment))}}}, nil
+		}
+
+		// Create the issue with the comment body as description
+		issue, err := linearClient.CreateIssue(ctx, linear.CreateIssueInput{
+			Title:       title,
+			TeamID:      teamID,
+			Description: comment.Body,
+		})
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to create issue: %v", err)}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Converted comment %s into a new issue:\n", formatCommentIdentifier(comment))
+		resultText += formatIssue(issue)
+		resultText += formatTeamIdentifier(issue.Team)
+
+		// Post the back-reference comment, best-effort: the issue is already
+		// created, so a comment failure is reported without failing the conversion
+		backRef := fmt.Sprintf("Created from comment %s", comment.URL)
+		if comment.URL == "" {
+			backRef = fmt.Sprintf("Created from comment %s", comment.ID)
+		}
+		if _, _, err := linearClient.AddComment(ctx, linear.AddCommentInput{IssueID: issue.ID, Body: backRef}); err != nil {
+			resultText += fmt.Sprintf("\nWarning: failed to add back-reference comment: %v", err)
+		} else {
+			resultText += "\nPosted back-reference comment on the new issue."
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
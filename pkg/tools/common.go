@@ -2,12 +2,56 @@ package tools
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/geropl/linear-mcp-go/pkg/linear"
 	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
 )
 
+// validateIcon checks that an icon value is plausible before sending it to the
+// API. Linear accepts an emoji or one of its predefined icon names, so only
+// values that are certainly wrong are rejected here; they would otherwise
+// surface as an opaque GraphQL error.
+func validateIcon(icon string) error {
+	if strings.TrimSpace(icon) == "" {
+		return fmt.Errorf("icon must not be empty")
+	}
+	if strings.ContainsAny(icon, " \t\n") {
+		return fmt.Errorf("icon '%s' must not contain whitespace", icon)
+	}
+	if utf8.RuneCountInString(icon) > 64 {
+		return fmt.Errorf("icon '%s' is too long (max 64 characters)", icon)
+	}
+	return nil
+}
+
+// validateHexColor checks that a color is a '#RRGGBB' hex string, the format
+// the Linear API expects for label colors.
+func validateHexColor(color string) error {
+	if len(color) != 7 || color[0] != '#' {
+		return fmt.Errorf("color '%s' must be a '#RRGGBB' hex string", color)
+	}
+	for _, c := range color[1:] {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') && (c < 'A' || c > 'F') {
+			return fmt.Errorf("color '%s' must be a '#RRGGBB' hex string", color)
+		}
+	}
+	return nil
+}
+
+// requireConfirmation guards destructive tools against accidental invocation.
+// It returns nil when the request carries confirm=true, and a refusal result
+// otherwise. Destructive write tools must call it before touching the API.
+func requireConfirmation(request mcp.CallToolRequest, action string) *mcp.CallToolResult {
+	if request.GetBool("confirm", false) {
+		return nil
+	}
+	return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Refusing to %s: set confirm=true to proceed. This guard protects against accidental destructive operations.", action)}}}
+}
+
 // resolveIssueIdentifier resolves an issue identifier (UUID or "TEAM-123") to a UUID
 func resolveIssueIdentifier(linearClient *linear.LinearClient, identifier string) (string, error) {
 	// If it's a valid UUID, use it directly
@@ -29,35 +73,53 @@ func resolveParentIssueIdentifier(linearClient *linear.LinearClient, identifier
 	return resolveIssueIdentifier(linearClient, identifier)
 }
 
-// resolveUserIdentifier resolves a user identifier (UUID, name, or email) to a UUID
-func resolveUserIdentifier(linearClient *linear.LinearClient, identifier string) (string, error) {
+// resolveUserIdentifier resolves a user identifier (UUID, name, display name, or email) to a UUID
+func resolveUserIdentifier(resolver *linear.Resolver, identifier string) (string, error) {
 	// If it's a valid UUID, use it directly
 	if isValidUUID(identifier) {
 		return identifier, nil
 	}
 
-	// Otherwise, try to find a user by name or email
+	// "@alexp" style handles match against the display name
+	identifier = strings.TrimPrefix(identifier, "@")
+
+	// Otherwise, try to find a user by name, display name, or email
 	// Get the organization to access all users
-	org, err := linearClient.GetOrganization()
+	org, err := resolver.Organization()
 	if err != nil {
 		return "", fmt.Errorf("failed to get organization: %v", err)
 	}
 
-	// First try exact match on name or email
+	// First try exact match on name, display name, or email
+	var matches []linear.User
 	for _, user := range org.Users {
-		if user.Name == identifier || user.Email == identifier {
-			return user.ID, nil
+		if user.Name == identifier || user.DisplayName == identifier || user.Email == identifier {
+			matches = append(matches, user)
 		}
 	}
 
 	// If no exact match, try case-insensitive match
-	identifierLower := strings.ToLower(identifier)
-	for _, user := range org.Users {
-		if strings.ToLower(user.Name) == identifierLower || strings.ToLower(user.Email) == identifierLower {
-			return user.ID, nil
+	if len(matches) == 0 {
+		identifierLower := strings.ToLower(identifier)
+		for _, user := range org.Users {
+			if strings.ToLower(user.Name) == identifierLower || strings.ToLower(user.DisplayName) == identifierLower || strings.ToLower(user.Email) == identifierLower {
+				matches = append(matches, user)
+			}
 		}
 	}
 
+	if len(matches) > 1 {
+		descriptions := make([]string, 0, len(matches))
+		for _, user := range matches {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s)", user.Name, user.Email))
+		}
+		return "", fmt.Errorf("multiple users match identifier '%s': %s; use the UUID or email instead", identifier, strings.Join(descriptions, ", "))
+	}
+
+	if len(matches) == 1 {
+		return matches[0].ID, nil
+	}
+
 	return "", fmt.Errorf("no user found with identifier '%s'", identifier)
 }
 
@@ -102,7 +164,7 @@ func resolveLabelIdentifiers(linearClient *linear.LinearClient, teamID string, l
 			}
 		}
 
-		return nil, fmt.Errorf("label(s) not found: %s", strings.Join(missingLabels, ", "))
+		return nil, fmt.Errorf("label(s) not found on the team: %s", strings.Join(missingLabels, ", "))
 	}
 
 	// Add the resolved label UUIDs to the result
@@ -144,32 +206,94 @@ func resolveCommentIdentifier(linearClient *linear.LinearClient, identifier stri
 }
 
 // resolveTeamIdentifier resolves a team identifier (UUID, name, or key) to a team ID
-func resolveTeamIdentifier(linearClient *linear.LinearClient, identifier string) (string, error) {
+func resolveTeamIdentifier(resolver *linear.Resolver, identifier string) (string, error) {
 	// If it's a valid UUID, use it directly
 	if isValidUUID(identifier) {
 		return identifier, nil
 	}
 
 	// Otherwise, try to find a team by name or key
-	teams, err := linearClient.GetTeams("")
+	teams, err := resolver.Teams()
 	if err != nil {
 		return "", fmt.Errorf("failed to get teams: %v", err)
 	}
 
 	// First try exact match on name or key
+	var matches []linear.Team
 	for _, team := range teams {
 		if team.Name == identifier || team.Key == identifier {
-			return team.ID, nil
+			matches = append(matches, team)
 		}
 	}
 
 	// If no exact match, try case-insensitive match
-	identifierLower := strings.ToLower(identifier)
-	for _, team := range teams {
-		if strings.ToLower(team.Name) == identifierLower || strings.ToLower(team.Key) == identifierLower {
-			return team.ID, nil
+	if len(matches) == 0 {
+		identifierLower := strings.ToLower(identifier)
+		for _, team := range teams {
+			if strings.ToLower(team.Name) == identifierLower || strings.ToLower(team.Key) == identifierLower {
+				matches = append(matches, team)
+			}
 		}
 	}
 
+	if len(matches) > 1 {
+		descriptions := make([]string, 0, len(matches))
+		for _, team := range matches {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s, %s)", team.Name, team.Key, team.ID))
+		}
+		return "", fmt.Errorf("multiple teams match identifier '%s': %s; use the UUID instead", identifier, strings.Join(descriptions, ", "))
+	}
+
+	if len(matches) == 1 {
+		return matches[0].ID, nil
+	}
+
 	return "", fmt.Errorf("no team found with identifier '%s'", identifier)
 }
+
+// resolveCycleIdentifier resolves a cycle identifier to a cycle UUID within a
+// team. Accepts a cycle number, or the keywords "current" (the team's active
+// cycle) and "next" (the cycle following the active one). UUIDs are expected
+// to be recognized by the caller before resolving.
+func resolveCycleIdentifier(linearClient *linear.LinearClient, teamID string, identifier string) (string, error) {
+	if identifier == "current" || identifier == "next" {
+		active, err := linearClient.GetTeamActiveCycle(teamID)
+		if err != nil {
+			return "", err
+		}
+		if active == nil {
+			return "", fmt.Errorf("the team has no active cycle")
+		}
+		if identifier == "current" {
+			return active.ID, nil
+		}
+
+		// "next" is the cycle with the smallest number after the active one
+		cycles, err := linearClient.GetTeamCycles(teamID)
+		if err != nil {
+			return "", err
+		}
+		for _, cycle := range cycles {
+			if cycle.Number > active.Number {
+				return cycle.ID, nil
+			}
+		}
+		return "", fmt.Errorf("the team has no cycle after the active cycle %d", active.Number)
+	}
+
+	number, err := strconv.Atoi(identifier)
+	if err != nil {
+		return "", fmt.Errorf("invalid cycle '%s': expected a cycle UUID, number, 'current' or 'next'", identifier)
+	}
+
+	cycles, err := linearClient.GetTeamCycles(teamID)
+	if err != nil {
+		return "", err
+	}
+	for _, cycle := range cycles {
+		if cycle.Number == number {
+			return cycle.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no cycle with number %d in the team", number)
+}
@@ -0,0 +1,110 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CompareIssuesTool is the tool definition for comparing two issues
+var CompareIssuesTool = mcp.NewTool("linear_compare_issues",
+	mcp.WithDescription("Fetches two issues and renders their title, description, status, and labels side by side, highlighting differences. Useful for duplicate triage."),
+	mcp.WithString("issue1", mcp.Required(), mcp.Description("First issue ID or identifier (e.g., 'TEAM-123')")),
+	mcp.WithString("issue2", mcp.Required(), mcp.Description("Second issue ID or identifier (e.g., 'TEAM-124')")),
+)
+
+// CompareIssuesHandler handles the linear_compare_issues tool
+func CompareIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		identifier1, err := request.RequireString("issue1")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		identifier2, err := request.RequireString("issue2")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Fetch both issues with their labels
+		fetch := func(identifier string) (*linear.Issue, []linear.Label, error) {
+			issueID, err := resolveIssueIdentifier(linearClient, identifier)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to resolve issue '%s': %v", identifier, err)
+			}
+			issue, err := linearClient.GetIssue(ctx, issueID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get issue '%s': %v", identifier, err)
+			}
+			labels, err := linearClient.GetIssueLabels(issueID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get labels of issue '%s': %v", identifier, err)
+			}
+			return issue, labels, nil
+		}
+
+		issue1, labels1, err := fetch(identifier1)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to compare issues: %v", err)}}}, nil
+		}
+
+		issue2, labels2, err := fetch(identifier2)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to compare issues: %v", err)}}}, nil
+		}
+
+		// Render the comparison field by field
+		resultText := fmt.Sprintf("Comparing %s and %s:\n", issue1.Identifier, issue2.Identifier)
+
+		compareField := func(name, value1, value2 string) {
+			if value1 == "" {
+				value1 = "(empty)"
+			}
+			if value2 == "" {
+				value2 = "(empty)"
+			}
+			if value1 == value2 {
+				resultText += fmt.Sprintf("%s [same]: %s\n", name, value1)
+			} else {
+				resultText += fmt.Sprintf("%s [differs]:\n", name)
+				resultText += fmt.Sprintf("  %s: %s\n", issue1.Identifier, value1)
+				resultText += fmt.Sprintf("  %s: %s\n", issue2.Identifier, value2)
+			}
+		}
+
+		stateName := func(issue *linear.Issue) string {
+			if issue.State != nil {
+				return issue.State.Name
+			}
+			return ""
+		}
+
+		priorityStr := func(issue *linear.Issue) string {
+			if issue.Priority > 0 {
+				return strconv.Itoa(issue.Priority)
+			}
+			return "None"
+		}
+
+		labelNames := func(labels []linear.Label) string {
+			names := make([]string, 0, len(labels))
+			for _, label := range labels {
+				names = append(names, label.Name)
+			}
+			return strings.Join(names, ", ")
+		}
+
+		compareField("Title", issue1.Title, issue2.Title)
+		compareField("Status", stateName(issue1), stateName(issue2))
+		compareField("Priority", priorityStr(issue1), priorityStr(issue2))
+		compareField("Labels", labelNames(labels1), labelNames(labels2))
+		compareField("Description", issue1.Description, issue2.Description)
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CompletedSinceTool is the tool definition for listing recently completed issues
+var CompletedSinceTool = mcp.NewTool("linear_completed_since",
+	mcp.WithDescription("Lists a team's issues completed after a given date, rendered for changelog / release-note use."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key)")),
+	mcp.WithString("since", mcp.Required(), mcp.Description("Date (YYYY-MM-DD) or RFC3339 timestamp; issues completed after this instant are returned")),
+	mcp.WithNumber("limit", mcp.Description("Maximum number of issues to return (default: 50)")),
+)
+
+// CompletedSinceHandler handles the linear_completed_since tool
+func CompletedSinceHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		sinceStr, err := request.RequireString("since")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		since, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			since, err = time.Parse("2006-01-02", sinceStr)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid since date '%s': expected YYYY-MM-DD or RFC3339 timestamp", sinceStr)}}}, nil
+			}
+		}
+
+		limit := request.GetInt("limit", 50)
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Get the completed issues
+		issues, err := linearClient.GetCompletedIssuesSince(teamID, since, limit)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get completed issues: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Found %d issues completed since %s:\n", len(issues), since.Format("2006-01-02"))
+		for _, issue := range issues {
+			completedStr := "unknown"
+			if issue.CompletedAt != nil {
+				completedStr = issue.CompletedAt.Format("2006-01-02")
+			}
+			resultText += fmt.Sprintf("- %s: %s (completed %s)\n", issue.Identifier, issue.Title, completedStr)
+			resultText += fmt.Sprintf("  URL: %s\n", issue.URL)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/geropl/linear-mcp-go/pkg/linear"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -19,6 +20,12 @@ var CreateIssueTool = mcp.NewTool("linear_create_issue",
 	mcp.WithString("status", mcp.Description("Issue status")),
 	mcp.WithString("parentIssue", mcp.Description("Optional parent issue ID or identifier (e.g., 'TEAM-123') to create a sub-issue")),
 	mcp.WithString("labels", mcp.Description("Optional comma-separated list of label IDs or names to assign")),
+	mcp.WithString("assignee", mcp.Description("Optional assignee identifier (UUID, name, or email) to assign the issue to")),
+	mcp.WithString("project", mcp.Description("Optional project (ID, name or slug) to create the issue in")),
+	mcp.WithString("milestone", mcp.Description("Optional milestone (ID or name) to create the issue in; requires the milestone's project")),
+	mcp.WithString("dueDate", mcp.Description("Due date (YYYY-MM-DD) or relative to today: '+3d' days, '+2w' weeks, '+5b' business days")),
+	mcp.WithString("cycle", mcp.Description("Cycle to create the issue into, as a cycle UUID, cycle number, 'current' or 'next' (resolved within the team)")),
+	mcp.WithString("comment", mcp.Description("Optional comment body to post on the issue right after creation")),
 )
 
 // CreateIssueHandler handles the linear_create_issue tool
@@ -36,7 +43,8 @@ func CreateIssueHandler(linearClient *linear.LinearClient) func(ctx context.Cont
 		}
 
 		// Resolve team identifier to a team ID
-		teamId, err := resolveTeamIdentifier(linearClient, team)
+		resolver := linearClient.NewResolver()
+		teamId, err := resolveTeamIdentifier(resolver, team)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
 		}
@@ -77,12 +85,64 @@ func CreateIssueHandler(linearClient *linear.LinearClient) func(ctx context.Cont
 			if len(labelIdentifiers) > 0 {
 				resolvedLabelIDs, err := resolveLabelIdentifiers(linearClient, teamId, labelIdentifiers)
 				if err != nil {
-					return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve labels: %v", err)}}}, nil
+					// Name the resolved team so a label that lives on another
+					// team fails loudly instead of as a bare "not found"
+					return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve labels against team '%s' (%s): %v", team, teamId, err)}}}, nil
 				}
 				labelIDs = resolvedLabelIDs
 			}
 		}
 
+		// Extract dueDate parameter and resolve relative expressions
+		var dueDate string
+		if dueDateStr := request.GetString("dueDate", ""); dueDateStr != "" {
+			dueDate, err = parseDueDate(dueDateStr, time.Now())
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to parse due date: %v", err)}}}, nil
+			}
+		}
+
+		// Resolve the target project and milestone identifiers to their IDs
+		var projectID string
+		if project := request.GetString("project", ""); project != "" {
+			resolvedProject, err := linearClient.GetProject(project)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve project: %v", err)}}}, nil
+			}
+			projectID = resolvedProject.ID
+		}
+
+		var milestoneID string
+		if milestone := request.GetString("milestone", ""); milestone != "" {
+			resolvedMilestone, err := linearClient.GetMilestone(milestone)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve milestone: %v", err)}}}, nil
+			}
+			milestoneID = resolvedMilestone.ID
+		}
+
+		// Resolve the assignee identifier (UUID, name, or email) to a user ID
+		var assigneeID string
+		if assignee := request.GetString("assignee", ""); assignee != "" {
+			assigneeID, err = resolveUserIdentifier(resolver, assignee)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve assignee: %v", err)}}}, nil
+			}
+		}
+
+		// Resolve the cycle identifier (UUID, number, "current" or "next") to a cycle ID
+		var cycleID string
+		if cycle := request.GetString("cycle", ""); cycle != "" {
+			if isValidUUID(cycle) {
+				cycleID = cycle
+			} else {
+				cycleID, err = resolveCycleIdentifier(linearClient, teamId, cycle)
+				if err != nil {
+					return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve cycle: %v", err)}}}, nil
+				}
+			}
+		}
+
 		// Create the issue
 		input := linear.CreateIssueInput{
 			Title:       title,
@@ -92,17 +152,46 @@ func CreateIssueHandler(linearClient *linear.LinearClient) func(ctx context.Cont
 			Status:      status,
 			ParentID:    parentID,
 			LabelIDs:    labelIDs,
+			DueDate:     dueDate,
+			CycleID:     cycleID,
+			AssigneeID:  assigneeID,
+			ProjectID:   projectID,
+			MilestoneID: milestoneID,
 		}
 
-		issue, err := linearClient.CreateIssue(input)
+		issue, err := linearClient.CreateIssue(ctx, input)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to create issue: %v", err)}}}, nil
 		}
 
-		// Return the result
-		resultText := fmt.Sprintf("Created %s", formatIssueIdentifier(issue))
-		resultText += fmt.Sprintf("\nTitle: %s", issue.Title)
-		resultText += fmt.Sprintf("\nURL: %s", issue.URL)
+		// Return the full created entity so no follow-up get_issue call is needed
+		resultText := "Created issue:\n"
+		resultText += formatIssue(issue)
+		if issue.Assignee != nil {
+			resultText += fmt.Sprintf("Assignee: %s\n", formatUserIdentifier(issue.Assignee))
+		} else {
+			resultText += "Assignee: None\n"
+		}
+		resultText += formatTeamIdentifier(issue.Team)
+
+		// Apply configured on-create triage rules, best-effort: the issue is
+		// already created, so a rule failure is reported without failing the create
+		if summary, err := applyOnCreateRule(linearClient, issue, teamId, team); err != nil {
+			resultText += fmt.Sprintf("\nWarning: on-create rule failed: %v", err)
+		} else if summary != "" {
+			resultText += fmt.Sprintf("\nApplied on-create rule: %s", summary)
+		}
+
+		// Post the initial comment, best-effort: the issue is already created,
+		// so a comment failure is reported without failing the create
+		if comment := request.GetString("comment", ""); comment != "" {
+			if _, _, err := linearClient.AddComment(ctx, linear.AddCommentInput{IssueID: issue.ID, Body: comment}); err != nil {
+				resultText += fmt.Sprintf("\nWarning: failed to add comment: %v", err)
+			} else {
+				resultText += "\nAdded initial comment."
+			}
+		}
+
 		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
 	}
 }
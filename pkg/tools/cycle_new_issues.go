@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CycleNewIssuesTool is the tool definition for listing issues created during the active cycle
+var CycleNewIssuesTool = mcp.NewTool("linear_cycle_new_issues",
+	mcp.WithDescription("Lists a team's issues created during the currently active cycle, for sprint reports on mid-cycle scope growth."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key)")),
+	mcp.WithNumber("limit", mcp.Description("Maximum number of issues to return (default: 50)")),
+)
+
+// CycleNewIssuesHandler handles the linear_cycle_new_issues tool
+func CycleNewIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		limit := request.GetInt("limit", 50)
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Get the active cycle for its start/end window
+		cycle, err := linearClient.GetTeamActiveCycle(teamID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get active cycle: %v", err)}}}, nil
+		}
+		if cycle == nil {
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Team '%s' has no active cycle.\n", team)}}}, nil
+		}
+		if cycle.StartsAt == nil || cycle.EndsAt == nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Active cycle %d has no start/end dates", cycle.Number)}}}, nil
+		}
+
+		// Get the issues created within the cycle window
+		issues, err := linearClient.GetIssuesCreatedBetween(teamID, *cycle.StartsAt, *cycle.EndsAt, limit)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issues: %v", err)}}}, nil
+		}
+
+		// Format the result
+		cycleName := fmt.Sprintf("Cycle %d", cycle.Number)
+		if cycle.Name != "" {
+			cycleName = fmt.Sprintf("%s (%s)", cycleName, cycle.Name)
+		}
+		resultText := fmt.Sprintf("%s: %s to %s\n", cycleName, cycle.StartsAt.Format("2006-01-02"), cycle.EndsAt.Format("2006-01-02"))
+		resultText += fmt.Sprintf("Found %d issues created during the cycle:\n", len(issues))
+		for _, issue := range issues {
+			statusStr := ""
+			if issue.State != nil {
+				statusStr = fmt.Sprintf(" [%s]", issue.State.Name)
+			}
+			resultText += fmt.Sprintf("- %s: %s%s (created %s)\n", issue.Identifier, issue.Title, statusStr, issue.CreatedAt.Format("2006-01-02"))
+			resultText += fmt.Sprintf("  URL: %s\n", issue.URL)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
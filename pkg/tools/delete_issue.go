@@ -0,0 +1,46 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// DeleteIssueTool is the tool definition for deleting (trashing) issues
+var DeleteIssueTool = mcp.NewTool("linear_delete_issue",
+	mcp.WithDescription("Deletes a Linear issue, moving it to the trash. This is distinct from archiving: trashed issues are scheduled for permanent deletion. Requires confirm=true."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("ID or identifier (e.g., 'TEAM-123') of the issue to delete")),
+	mcp.WithBoolean("confirm", mcp.Required(), mcp.Description("Must be true to actually delete the issue. Guards against accidental deletes.")),
+)
+
+// DeleteIssueHandler handles the linear_delete_issue tool
+func DeleteIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Require explicit confirmation before doing anything destructive
+		if refusal := requireConfirmation(request, fmt.Sprintf("delete issue '%s'", issueIdentifier)); refusal != nil {
+			return refusal, nil
+		}
+
+		// Resolve issue identifier to a UUID
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		// Delete the issue
+		if err := linearClient.DeleteIssue(issueID); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to delete issue: %v", err)}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Moved issue %s (UUID: %s) to trash.", issueIdentifier, issueID)
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,53 @@
+package tools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseDueDate parses a due date that is either absolute ("2006-01-02") or relative
+// to now: "+3d" (days), "+2w" (weeks), or "+5b" (business days, skipping weekends).
+// It returns the resolved date formatted for the Linear API.
+func parseDueDate(value string, now time.Time) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("due date must not be empty")
+	}
+
+	if strings.HasPrefix(value, "+") {
+		if len(value) < 3 {
+			return "", fmt.Errorf("invalid relative due date '%s': expected '+<n>d', '+<n>w', or '+<n>b'", value)
+		}
+
+		n, err := strconv.Atoi(value[1 : len(value)-1])
+		if err != nil || n < 0 {
+			return "", fmt.Errorf("invalid relative due date '%s': expected '+<n>d', '+<n>w', or '+<n>b'", value)
+		}
+
+		switch value[len(value)-1] {
+		case 'd':
+			return now.AddDate(0, 0, n).Format("2006-01-02"), nil
+		case 'w':
+			return now.AddDate(0, 0, 7*n).Format("2006-01-02"), nil
+		case 'b':
+			date := now
+			for remaining := n; remaining > 0; {
+				date = date.AddDate(0, 0, 1)
+				if weekday := date.Weekday(); weekday != time.Saturday && weekday != time.Sunday {
+					remaining--
+				}
+			}
+			return date.Format("2006-01-02"), nil
+		default:
+			return "", fmt.Errorf("invalid relative due date '%s': expected '+<n>d', '+<n>w', or '+<n>b'", value)
+		}
+	}
+
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return "", fmt.Errorf("invalid due date '%s': expected YYYY-MM-DD or a relative expression like '+3d'", value)
+	}
+
+	return value, nil
+}
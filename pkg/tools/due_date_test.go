@@ -0,0 +1,46 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDueDate(t *testing.T) {
+	// Wednesday, so business-day arithmetic crosses a weekend
+	now := time.Date(2025, 7, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "2025-08-01", want: "2025-08-01"},
+		{value: "+0d", want: "2025-07-02"},
+		{value: "+3d", want: "2025-07-05"},
+		{value: "+1w", want: "2025-07-09"},
+		{value: "+3b", want: "2025-07-07"},
+		{value: "+5b", want: "2025-07-09"},
+		{value: "", wantErr: true},
+		{value: "+d", wantErr: true},
+		{value: "+3x", wantErr: true},
+		{value: "+-1d", wantErr: true},
+		{value: "tomorrow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDueDate(tt.value, now)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseDueDate(%q) expected error, got %q", tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseDueDate(%q) unexpected error: %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseDueDate(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
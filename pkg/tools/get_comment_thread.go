@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetCommentThreadTool is the tool definition for getting a full comment thread
+var GetCommentThreadTool = mcp.NewTool("linear_get_comment_thread",
+	mcp.WithDescription("Retrieves a comment and its full reply tree, rendered with indentation. Useful for summarizing a whole discussion at once instead of paging through replies."),
+	mcp.WithString("comment", mcp.Required(), mcp.Description("UUID of the parent comment whose thread to retrieve")),
+	mcp.WithNumber("maxDepth", mcp.Description("Maximum reply nesting depth to follow (default: 5, max: 10)")),
+	mcp.WithBoolean("relativeTime", mcp.Description("Render timestamps with relative phrasing, e.g. '3 days ago' (default: false)")),
+)
+
+// GetCommentThreadHandler handles the linear_get_comment_thread tool
+func GetCommentThreadHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		commentID, err := request.RequireString("comment")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		if !isValidUUID(commentID) {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid comment ID '%s': expected a UUID", commentID)}}}, nil
+		}
+
+		maxDepth := request.GetInt("maxDepth", 5)
+		if maxDepth < 0 {
+			maxDepth = 0
+		}
+		if maxDepth > 10 {
+			maxDepth = 10
+		}
+
+		relative := request.GetBool("relativeTime", false)
+
+		// Render the thread recursively, one API call per comment
+		var resultText string
+		var render func(id string, depth int) error
+		render = func(id string, depth int) error {
+			comment, err := linearClient.GetComment(id)
+			if err != nil {
+				return err
+			}
+
+			indent := strings.Repeat("  ", depth)
+			resultText += fmt.Sprintf("%s- ID: %s\n", indent, formatCommentIdentifier(comment))
+			resultText += fmt.Sprintf("%s  %s\n", indent, formatUserIdentifier(comment.User))
+			createdAt := formatTimestamp(comment.CreatedAt, relative)
+			if comment.EditedAt != nil {
+				createdAt += " (edited)"
+			}
+			resultText += fmt.Sprintf("%s  CreatedAt: %s\n", indent, createdAt)
+			resultText += fmt.Sprintf("%s  Body: %s\n", indent, comment.Body)
+
+			if comment.Children == nil || len(comment.Children.Nodes) == 0 {
+				return nil
+			}
+
+			if depth >= maxDepth {
+				resultText += fmt.Sprintf("%s  (replies omitted, max depth %d reached)\n", indent, maxDepth)
+				return nil
+			}
+
+			for _, child := range comment.Children.Nodes {
+				if err := render(child.ID, depth+1); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if err := render(commentID, 0); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get comment thread: %v", err)}}}, nil
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
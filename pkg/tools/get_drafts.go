@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetDraftsTool is the tool definition for listing the viewer's draft issues
+var GetDraftsTool = mcp.NewTool("linear_get_drafts",
+	mcp.WithDescription("Lists the viewer's draft issues, so work started in the Linear UI can be picked up and finished."),
+	mcp.WithNumber("limit", mcp.Description("Maximum number of drafts to return (default: 25)")),
+)
+
+// GetDraftsHandler handles the linear_get_drafts tool
+func GetDraftsHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		limit := request.GetInt("limit", 25)
+
+		// Get the viewer's drafts
+		drafts, err := linearClient.GetDrafts(limit)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get drafts: %v", err)}}}, nil
+		}
+
+		// Format the result
+		if len(drafts) == 0 {
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "No draft issues found.\n"}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Found %d draft issues:\n", len(drafts))
+		for _, draft := range drafts {
+			resultText += fmt.Sprintf("- %s (UUID: %s)\n", draft.Title, draft.ID)
+			if draft.Team != nil {
+				resultText += fmt.Sprintf("  %s\n", formatTeamIdentifier(draft.Team))
+			}
+			resultText += fmt.Sprintf("  Updated: %s\n", draft.UpdatedAt.Format("2006-01-02 15:04:05"))
+			if draft.Description != "" {
+				resultText += fmt.Sprintf("  Description: %s\n", draft.Description)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
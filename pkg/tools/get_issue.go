@@ -12,6 +12,10 @@ import (
 var GetIssueTool = mcp.NewTool("linear_get_issue",
 	mcp.WithDescription("Retrieves a single Linear issue."),
 	mcp.WithString("issue", mcp.Required(), mcp.Description("ID or identifier (e.g., 'TEAM-123') of the issue to retrieve")),
+	mcp.WithBoolean("includeCycleHistory", mcp.Description("Include the issue's cycle assignment history (when it entered which cycle)")),
+	mcp.WithBoolean("relativeTime", mcp.Description("Render history timestamps with relative phrasing, e.g. '3 days ago' (default: false)")),
+	mcp.WithString("format", mcp.Description("Output format: 'text' (default) or 'json'")),
+	mcp.WithBoolean("structured", mcp.Description("Additionally return the issue as a structured JSON content block alongside the text (default: false)")),
 )
 
 // GetIssueHandler handles the linear_get_issue tool
@@ -23,6 +27,11 @@ func GetIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
 		}
 
+		format := request.GetString("format", "text")
+		if format != "text" && format != "json" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Unknown format '%s': expected 'text' or 'json'", format)}}}, nil
+		}
+
 		// Resolve issue identifier to a UUID
 		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
 		if err != nil {
@@ -30,11 +39,15 @@ func GetIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context
 		}
 
 		// Get the issue
-		issue, err := linearClient.GetIssue(issueID)
+		issue, err := linearClient.GetIssue(ctx, issueID)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issue: %v", err)}}}, nil
 		}
 
+		if format == "json" {
+			return jsonResult(issue), nil
+		}
+
 		// Format the result using the full issue formatting
 		resultText := formatIssue(issue)
 
@@ -65,7 +78,7 @@ func GetIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context
 
 			// Display all attachments in a simple list without grouping by source type
 			for _, attachment := range issue.Attachments.Nodes {
-				resultText += fmt.Sprintf("- %s: %s\n", attachment.Title, attachment.URL)
+				resultText += fmt.Sprintf("- %s: %s (UUID: %s)\n", attachment.Title, attachment.URL, attachment.ID)
 				if attachment.Subtitle != "" {
 					resultText += fmt.Sprintf("  %s\n", attachment.Subtitle)
 				}
@@ -83,11 +96,15 @@ func GetIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context
 			if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
 				for _, relation := range issue.Relations.Nodes {
 					if relation.RelatedIssue != nil {
-						resultText += fmt.Sprintf("- %s\n  Title: %s\n  RelationType: %s\n  URL: %s\n",
+						resultText += fmt.Sprintf("- %s\n  Title: %s\n  RelationType: %s\n  Relation UUID: %s\n",
 							formatIssueIdentifier(relation.RelatedIssue),
 							relation.RelatedIssue.Title,
 							relation.Type,
-							relation.RelatedIssue.URL)
+							relation.ID)
+						if relation.RelatedIssue.State != nil {
+							resultText += fmt.Sprintf("  Status: %s (%s)\n", relation.RelatedIssue.State.Name, relation.RelatedIssue.State.Type)
+						}
+						resultText += fmt.Sprintf("  URL: %s\n", relation.RelatedIssue.URL)
 					}
 				}
 			}
@@ -96,11 +113,15 @@ func GetIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context
 			if issue.InverseRelations != nil && len(issue.InverseRelations.Nodes) > 0 {
 				for _, relation := range issue.InverseRelations.Nodes {
 					if relation.Issue != nil {
-						resultText += fmt.Sprintf("- %s\n  Title: %s\n  RelationType: %s (inverse)\n  URL: %s\n",
+						resultText += fmt.Sprintf("- %s\n  Title: %s\n  RelationType: %s (inverse)\n  Relation UUID: %s\n",
 							formatIssueIdentifier(relation.Issue),
 							relation.Issue.Title,
 							relation.Type,
-							relation.Issue.URL)
+							relation.ID)
+						if relation.Issue.State != nil {
+							resultText += fmt.Sprintf("  Status: %s (%s)\n", relation.Issue.State.Name, relation.Issue.State.Type)
+						}
+						resultText += fmt.Sprintf("  URL: %s\n", relation.Issue.URL)
 					}
 				}
 			}
@@ -108,9 +129,34 @@ func GetIssueHandler(linearClient *linear.LinearClient) func(ctx context.Context
 			resultText += "\nRelated Issues: None\n"
 		}
 
+		// Add cycle history section if requested
+		if request.GetBool("includeCycleHistory", false) {
+			entries, err := linearClient.GetIssueCycleHistory(issueID)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get cycle history: %v", err)}}}, nil
+			}
+
+			if len(entries) > 0 {
+				resultText += "\nCycle History:\n"
+				for _, entry := range entries {
+					cycleName := fmt.Sprintf("Cycle %d", entry.ToCycle.Number)
+					if entry.ToCycle.Name != "" {
+						cycleName = fmt.Sprintf("%s (%s)", cycleName, entry.ToCycle.Name)
+					}
+					resultText += fmt.Sprintf("- Added to %s at %s\n", cycleName, formatTimestamp(entry.CreatedAt, request.GetBool("relativeTime", false)))
+				}
+			} else {
+				resultText += "\nCycle History: None\n"
+			}
+		}
+
 		// Note about comments
 		resultText += "\nComments: Use the linear_get_issue_comments tool to retrieve comments for this issue.\n"
 
+		if request.GetBool("structured", false) {
+			return textAndStructuredResult(resultText, issue), nil
+		}
+
 		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
 	}
 }
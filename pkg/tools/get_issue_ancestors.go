@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxAncestorDepth bounds how many parent links linear_get_issue_ancestors
+// follows, as a guard against pathological hierarchies.
+const maxAncestorDepth = 10
+
+// GetIssueAncestorsTool is the tool definition for getting an issue's parent chain
+var GetIssueAncestorsTool = mcp.NewTool("linear_get_issue_ancestors",
+	mcp.WithDescription("Retrieves an issue's full parent chain (breadcrumb), from the root issue down to the issue itself. Useful for placing deeply nested sub-issues in context."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("ID or identifier (e.g., 'TEAM-123') of the issue whose ancestry to retrieve")),
+)
+
+// GetIssueAncestorsHandler handles the linear_get_issue_ancestors tool
+func GetIssueAncestorsHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve issue identifier to a UUID
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		// Get the issue itself for the bottom of the chain
+		issue, err := linearClient.GetIssue(ctx, issueID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issue: %v", err)}}}, nil
+		}
+
+		// Walk parent links upward, bounded by maxAncestorDepth
+		chain := []*linear.Issue{issue}
+		currentID := issue.ID
+		for len(chain) <= maxAncestorDepth {
+			parent, err := linearClient.GetIssueParent(currentID)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get parent issue: %v", err)}}}, nil
+			}
+			if parent == nil {
+				break
+			}
+			chain = append(chain, parent)
+			currentID = parent.ID
+		}
+
+		if len(chain) == 1 {
+			resultText := fmt.Sprintf("Issue %s is a top-level issue (no ancestors).\n", issue.Identifier)
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+		}
+
+		// Render the chain from the root down to the issue
+		resultText := fmt.Sprintf("Ancestry of %s (%d levels):\n", issue.Identifier, len(chain))
+		for i := len(chain) - 1; i >= 0; i-- {
+			ancestor := chain[i]
+			indent := strings.Repeat("  ", len(chain)-1-i)
+
+			status := ""
+			if ancestor.State != nil {
+				status = fmt.Sprintf(" [%s]", ancestor.State.Name)
+			}
+			resultText += fmt.Sprintf("%s- %s: %s%s\n", indent, ancestor.Identifier, ancestor.Title, status)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -15,6 +15,7 @@ var GetIssueCommentsTool = mcp.NewTool("linear_get_issue_comments",
 	mcp.WithString("thread", mcp.Description("Optional UUID of the parent comment / thread to retrieve replies for. If not provided, returns top-level comments.")),
 	mcp.WithNumber("limit", mcp.Description("Maximum number of comments to return (default: 10)")),
 	mcp.WithString("after", mcp.Description("Cursor for pagination, to get comments after this point")),
+	mcp.WithBoolean("relativeTime", mcp.Description("Render timestamps with relative phrasing, e.g. '3 days ago' (default: false)")),
 )
 
 // GetIssueCommentsHandler handles the linear_get_issue_comments tool
@@ -30,6 +31,7 @@ func GetIssueCommentsHandler(linearClient *linear.LinearClient) func(ctx context
 		parentID := request.GetString("thread", "")
 		limit := request.GetInt("limit", 10)
 		afterCursor := request.GetString("after", "")
+		relative := request.GetBool("relativeTime", false)
 
 		// Resolve issue identifier to a UUID
 		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
@@ -38,7 +40,7 @@ func GetIssueCommentsHandler(linearClient *linear.LinearClient) func(ctx context
 		}
 
 		// Get the issue for basic information
-		issue, err := linearClient.GetIssue(issueID)
+		issue, err := linearClient.GetIssue(ctx, issueID)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issue: %v", err)}}}, nil
 		}
@@ -76,7 +78,10 @@ func GetIssueCommentsHandler(linearClient *linear.LinearClient) func(ctx context
 			resultText += "Comments:\n"
 
 			for _, comment := range comments.Nodes {
-				createdAt := comment.CreatedAt.Format("2006-01-02 15:04:05")
+				createdAt := formatTimestamp(comment.CreatedAt, relative)
+				if comment.EditedAt != nil {
+					createdAt += " (edited)"
+				}
 				hasReplies := false
 				if comment.Children != nil && len(comment.Children.Nodes) > 0 {
 					hasReplies = true
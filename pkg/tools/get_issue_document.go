@@ -0,0 +1,89 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetIssueDocumentTool is the tool definition for getting an issue as one markdown document
+var GetIssueDocumentTool = mcp.NewTool("linear_get_issue_document",
+	mcp.WithDescription("Renders an issue's title, description, and all comments as a single markdown document. Follows comment pagination internally, so the whole discussion is returned at once."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("ID or identifier (e.g., 'TEAM-123') of the issue to render")),
+)
+
+// GetIssueDocumentHandler handles the linear_get_issue_document tool
+func GetIssueDocumentHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve issue identifier to a UUID
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		// Get the issue
+		issue, err := linearClient.GetIssue(ctx, issueID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issue: %v", err)}}}, nil
+		}
+
+		// Collect all top-level comments, following pagination until exhausted
+		var comments []linear.Comment
+		afterCursor := ""
+		for {
+			page, err := linearClient.GetIssueComments(linear.GetIssueCommentsInput{
+				IssueID:     issueID,
+				Limit:       50,
+				AfterCursor: afterCursor,
+			})
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get comments: %v", err)}}}, nil
+			}
+
+			comments = append(comments, page.Nodes...)
+
+			if !page.PageInfo.HasNextPage {
+				break
+			}
+			afterCursor = page.PageInfo.EndCursor
+		}
+
+		// Render the document
+		doc := fmt.Sprintf("# %s: %s\n\n", issue.Identifier, issue.Title)
+
+		if issue.Description != "" {
+			doc += issue.Description + "\n\n"
+		} else {
+			doc += "_No description._\n\n"
+		}
+
+		doc += "## Comments\n\n"
+
+		if len(comments) == 0 {
+			doc += "_No comments._\n"
+		} else {
+			for _, comment := range comments {
+				author := "Unknown"
+				if comment.User != nil {
+					author = comment.User.Name
+				}
+				timestamp := comment.CreatedAt.Format("2006-01-02 15:04:05")
+				if comment.EditedAt != nil {
+					timestamp += ", edited"
+				}
+				doc += fmt.Sprintf("### %s (%s)\n\n", author, timestamp)
+				doc += comment.Body + "\n\n"
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: doc}}}, nil
+	}
+}
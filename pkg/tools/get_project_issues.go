@@ -0,0 +1,59 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetProjectIssuesTool is the tool definition for listing a project's issues
+var GetProjectIssuesTool = mcp.NewTool("linear_get_project_issues",
+	mcp.WithDescription("Lists a project's active (non-completed) issues. Useful for summarizing project progress."),
+	mcp.WithString("project", mcp.Required(), mcp.Description("Project identifier (ID, name or slug)")),
+	mcp.WithNumber("limit", mcp.Description("Maximum number of issues to return (default: 50)")),
+)
+
+// GetProjectIssuesHandler handles the linear_get_project_issues tool
+func GetProjectIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		projectIdentifier, err := request.RequireString("project")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		limit := request.GetInt("limit", 50)
+
+		// Resolve the project identifier
+		project, err := linearClient.GetProject(projectIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve project: %v", err)}}}, nil
+		}
+
+		// Get the project's issues
+		issues, err := linearClient.GetProjectIssues(project.ID, limit)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get project issues: %v", err)}}}, nil
+		}
+
+		// Format the result
+		if len(issues) == 0 {
+			resultText := fmt.Sprintf("No active issues found in project '%s'.\n", project.Name)
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Found %d active issues in project '%s':\n", len(issues), project.Name)
+		for _, issue := range issues {
+			statusStr := ""
+			if issue.State != nil {
+				statusStr = fmt.Sprintf(" [%s]", issue.State.Name)
+			}
+			resultText += fmt.Sprintf("- %s: %s%s\n", issue.Identifier, issue.Title, statusStr)
+			resultText += fmt.Sprintf("  URL: %s\n", issue.URL)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetTeamTool is the tool definition for getting a single team in full detail
+var GetTeamTool = mcp.NewTool("linear_get_team",
+	mcp.WithDescription("Retrieves a single team with its description, workflow states, and labels."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (key, UUID or name)")),
+)
+
+// GetTeamHandler handles the linear_get_team tool
+func GetTeamHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		teamIdentifier, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve team identifier to a team ID
+		resolver := linearClient.NewResolver()
+		teamID, err := resolveTeamIdentifier(resolver, teamIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Find the full team in the resolver's team list
+		teams, err := resolver.Teams()
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get teams: %v", err)}}}, nil
+		}
+
+		var team *linear.Team
+		for i := range teams {
+			if teams[i].ID == teamID {
+				team = &teams[i]
+				break
+			}
+		}
+		if team == nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Team %s not found", teamID)}}}, nil
+		}
+
+		// Get the team's labels
+		labels, err := linearClient.GetTeamLabels(teamID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get labels: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := formatTeam(team)
+		if team.Description != "" {
+			resultText += fmt.Sprintf("Description: %s\n", team.Description)
+		} else {
+			resultText += "Description: None\n"
+		}
+
+		resultText += fmt.Sprintf("\nWorkflow states (%d):\n", len(team.States))
+		for _, state := range team.States {
+			resultText += fmt.Sprintf("- %s (UUID: %s)\n", state.Name, state.ID)
+		}
+
+		resultText += fmt.Sprintf("\nLabels (%d):\n", len(labels))
+		for _, label := range labels {
+			resultText += fmt.Sprintf("- %s\n", formatLabel(label))
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
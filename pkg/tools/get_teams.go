@@ -12,6 +12,7 @@ import (
 var GetTeamsTool = mcp.NewTool("linear_get_teams",
 	mcp.WithDescription("Retrieves Linear teams."),
 	mcp.WithString("name", mcp.Description("Optional team name filter. Returns teams whose names contain this string.")),
+	mcp.WithString("format", mcp.Description("Output format: 'text' (default) or 'json'")),
 )
 
 // GetTeamsHandler handles the linear_get_teams tool
@@ -20,12 +21,21 @@ func GetTeamsHandler(linearClient *linear.LinearClient) func(ctx context.Context
 		// Extract arguments
 		name := request.GetString("name", "")
 
+		format := request.GetString("format", "text")
+		if format != "text" && format != "json" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Unknown format '%s': expected 'text' or 'json'", format)}}}, nil
+		}
+
 		// Get teams
 		teams, err := linearClient.GetTeams(name)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get teams: %v", err)}}}, nil
 		}
 
+		if format == "json" {
+			return jsonResult(teams), nil
+		}
+
 		// Format the result
 		resultText := fmt.Sprintf("Found %d teams:\n", len(teams))
 		for _, team := range teams {
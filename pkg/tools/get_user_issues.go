@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/geropl/linear-mcp-go/pkg/linear"
@@ -15,6 +16,7 @@ var GetUserIssuesTool = mcp.NewTool("linear_get_user_issues",
 	mcp.WithString("user", mcp.Description("Optional user identifier (UUID, name, or email). If not provided, returns authenticated user's issues")),
 	mcp.WithBoolean("includeArchived", mcp.Description("Include archived issues in results")),
 	mcp.WithNumber("limit", mcp.Description("Maximum number of issues to return (default: 50)")),
+	mcp.WithBoolean("sortByPriority", mcp.Description("Sort results by priority, urgent first (default: false, API order)")),
 )
 
 // GetUserIssuesHandler handles the linear_get_user_issues tool
@@ -25,7 +27,7 @@ func GetUserIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Co
 
 		if user, err := request.RequireString("user"); err == nil && user != "" {
 			// Resolve user identifier to a user ID
-			userID, err := resolveUserIdentifier(linearClient, user)
+			userID, err := resolveUserIdentifier(linearClient.NewResolver(), user)
 			if err != nil {
 				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve user: %v", err)}}}, nil
 			}
@@ -41,6 +43,20 @@ func GetUserIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Co
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get user issues: %v", err)}}}, nil
 		}
 
+		// Sort by priority if requested: urgent (1) -> low (4), no priority (0) last
+		if request.GetBool("sortByPriority", false) {
+			sort.SliceStable(issues, func(i, j int) bool {
+				pi, pj := issues[i].Priority, issues[j].Priority
+				if pi == 0 {
+					return false
+				}
+				if pj == 0 {
+					return true
+				}
+				return pi < pj
+			})
+		}
+
 		// Format the result
 		resultText := fmt.Sprintf("Found %d issues:\n", len(issues))
 		for _, issue := range issues {
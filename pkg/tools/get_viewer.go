@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetViewerTool is the tool definition for getting the authenticated user
+var GetViewerTool = mcp.NewTool("linear_get_viewer",
+	mcp.WithDescription("Retrieves the authenticated Linear user, their teams, and their organization."),
+)
+
+// GetViewerHandler handles the linear_get_viewer tool
+func GetViewerHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get the viewer
+		user, teams, org, err := linearClient.GetViewer()
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get viewer: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("%s\n", formatUserIdentifier(user))
+		resultText += fmt.Sprintf("Email: %s\n", user.Email)
+		resultText += fmt.Sprintf("Admin: %s\n", formatBool(user.Admin))
+
+		if len(teams) > 0 {
+			resultText += "Teams:\n"
+			for _, team := range teams {
+				resultText += fmt.Sprintf("- %s (%s, UUID: %s)\n", team.Name, team.Key, team.ID)
+			}
+		} else {
+			resultText += "Teams: None\n"
+		}
+
+		if org != nil {
+			resultText += fmt.Sprintf("Organization: %s (urlKey: %s)\n", org.Name, org.URLKey)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
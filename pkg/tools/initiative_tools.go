@@ -12,6 +12,7 @@ import (
 var GetInitiativeTool = mcp.NewTool("linear_get_initiative",
 	mcp.WithDescription("Get a single initiative by its identifier (ID or name)."),
 	mcp.WithString("initiative", mcp.Required(), mcp.Description("The identifier of the initiative to get. Can be the initiative's ID or name.")),
+	mcp.WithString("format", mcp.Description("Output format: 'text' (default) or 'json'")),
 )
 
 func GetInitiativeHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -21,11 +22,20 @@ func GetInitiativeHandler(linearClient *linear.LinearClient) func(ctx context.Co
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
 		}
 
+		format := request.GetString("format", "text")
+		if format != "text" && format != "json" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Unknown format '%s': expected 'text' or 'json'", format)}}}, nil
+		}
+
 		initiative, err := linearClient.GetInitiative(initiativeIdentifier)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get initiative: %v", err)}}}, nil
 		}
 
+		if format == "json" {
+			return jsonResult(initiative), nil
+		}
+
 		resultText := FormatInitiative(*initiative)
 
 		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
@@ -43,10 +53,56 @@ func FormatInitiative(initiative linear.Initiative) string {
 	return builder.String()
 }
 
+var GetInitiativeUpdatesTool = mcp.NewTool("linear_get_initiative_updates",
+	mcp.WithDescription("Get the update feed of an initiative."),
+	mcp.WithString("initiative", mcp.Required(), mcp.Description("The identifier of the initiative to get updates for. Can be the initiative's ID or name.")),
+)
+
+func GetInitiativeUpdatesHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		initiativeIdentifier, err := request.RequireString("initiative")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		initiative, err := linearClient.GetInitiative(initiativeIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get initiative: %v", err)}}}, nil
+		}
+
+		updates, err := linearClient.GetInitiativeUpdates(initiative.ID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get initiative updates: %v", err)}}}, nil
+		}
+
+		if len(updates) == 0 {
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("No updates found for initiative '%s'.\n", initiative.Name)}}}, nil
+		}
+
+		var builder strings.Builder
+		builder.WriteString(fmt.Sprintf("Found %d updates for initiative '%s':\n", len(updates), initiative.Name))
+		for _, update := range updates {
+			userName := "Unknown"
+			if update.User != nil {
+				userName = update.User.Name
+			}
+			builder.WriteString(fmt.Sprintf("- Update by %s (UUID: %s)\n", userName, update.ID))
+			if update.Health != "" {
+				builder.WriteString(fmt.Sprintf("  Health: %s\n", update.Health))
+			}
+			builder.WriteString(fmt.Sprintf("  Created: %s\n", update.CreatedAt.Format("2006-01-02 15:04:05")))
+			builder.WriteString(fmt.Sprintf("  Body: %s\n", update.Body))
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: builder.String()}}}, nil
+	}
+}
+
 var CreateInitiativeTool = mcp.NewTool("linear_create_initiative",
 	mcp.WithDescription("Create a new initiative."),
 	mcp.WithString("name", mcp.Required(), mcp.Description("The name of the initiative.")),
 	mcp.WithString("description", mcp.Description("The description of the initiative.")),
+	mcp.WithString("icon", mcp.Description("The icon of the initiative (an emoji or a Linear icon name).")),
 )
 
 func CreateInitiativeHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -57,10 +113,17 @@ func CreateInitiativeHandler(linearClient *linear.LinearClient) func(ctx context
 		}
 
 		description := request.GetString("description", "")
+		icon := request.GetString("icon", "")
+		if icon != "" {
+			if err := validateIcon(icon); err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid icon: %v", err)}}}, nil
+			}
+		}
 
 		input := linear.InitiativeCreateInput{
 			Name:        name,
 			Description: description,
+			Icon:        icon,
 		}
 
 		initiative, err := linearClient.CreateInitiative(input)
@@ -79,6 +142,7 @@ var UpdateInitiativeTool = mcp.NewTool("linear_update_initiative",
 	mcp.WithString("initiative", mcp.Required(), mcp.Description("The ID or name of the initiative to update.")),
 	mcp.WithString("name", mcp.Description("The new name of the initiative.")),
 	mcp.WithString("description", mcp.Description("The new description of the initiative.")),
+	mcp.WithString("icon", mcp.Description("The new icon of the initiative (an emoji or a Linear icon name).")),
 )
 
 func UpdateInitiativeHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -88,6 +152,13 @@ func UpdateInitiativeHandler(linearClient *linear.LinearClient) func(ctx context
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
 		}
 
+		icon := request.GetString("icon", "")
+		if icon != "" {
+			if err := validateIcon(icon); err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid icon: %v", err)}}}, nil
+			}
+		}
+
 		// Get the initiative first to get its ID
 		init, err := linearClient.GetInitiative(initiativeIdentifier)
 		if err != nil {
@@ -100,6 +171,7 @@ func UpdateInitiativeHandler(linearClient *linear.LinearClient) func(ctx context
 		input := linear.InitiativeUpdateInput{
 			Name:        name,
 			Description: description,
+			Icon:        icon,
 		}
 
 		initiative, err := linearClient.UpdateInitiative(init.ID, input)
@@ -0,0 +1,110 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// validRelationTypes is the set of relation types accepted by the Linear API
+var validRelationTypes = map[string]bool{
+	"blocks":    true,
+	"duplicate": true,
+	"related":   true,
+}
+
+// CreateIssueRelationTool is the tool definition for creating issue relations
+var CreateIssueRelationTool = mcp.NewTool("linear_create_issue_relation",
+	mcp.WithDescription("Creates a relation between two Linear issues (blocks, duplicate, related)."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("ID or identifier (e.g., 'TEAM-123') of the issue the relation starts from")),
+	mcp.WithString("relatedIssue", mcp.Required(), mcp.Description("ID or identifier (e.g., 'TEAM-456') of the issue the relation points to")),
+	mcp.WithString("type", mcp.Required(), mcp.Description("Type of the relation: one of 'blocks', 'duplicate', 'related'")),
+)
+
+// DeleteIssueRelationTool is the tool definition for deleting issue relations
+var DeleteIssueRelationTool = mcp.NewTool("linear_delete_issue_relation",
+	mcp.WithDescription("Deletes a relation between two Linear issues. Requires confirm=true."),
+	mcp.WithString("relation", mcp.Required(), mcp.Description("UUID of the relation to delete (shown in the linear_get_issue output)")),
+	mcp.WithBoolean("confirm", mcp.Required(), mcp.Description("Must be true to actually delete the relation. Guards against accidental deletes.")),
+)
+
+// CreateIssueRelationHandler handles the linear_create_issue_relation tool
+func CreateIssueRelationHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		relatedIdentifier, err := request.RequireString("relatedIssue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		relationType, err := request.RequireString("type")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Validate the relation type
+		if !validRelationTypes[relationType] {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid relation type '%s': expected one of 'blocks', 'duplicate', 'related'", relationType)}}}, nil
+		}
+
+		// Resolve both issue identifiers to UUIDs
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		relatedIssueID, err := resolveIssueIdentifier(linearClient, relatedIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve related issue: %v", err)}}}, nil
+		}
+
+		// Create the relation
+		relation, err := linearClient.CreateIssueRelation(issueID, relatedIssueID, relationType)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to create issue relation: %v", err)}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Created relation (UUID: %s):\n", relation.ID)
+		if relation.Issue != nil && relation.RelatedIssue != nil {
+			resultText += fmt.Sprintf("- %s %s %s\n", relation.Issue.Identifier, relation.Type, relation.RelatedIssue.Identifier)
+		} else {
+			resultText += fmt.Sprintf("- %s %s %s\n", issueIdentifier, relation.Type, relatedIdentifier)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+// DeleteIssueRelationHandler handles the linear_delete_issue_relation tool
+func DeleteIssueRelationHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		relationID, err := request.RequireString("relation")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		if !isValidUUID(relationID) {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid relation UUID '%s'", relationID)}}}, nil
+		}
+
+		// Require explicit confirmation before doing anything destructive
+		if refusal := requireConfirmation(request, fmt.Sprintf("delete relation '%s'", relationID)); refusal != nil {
+			return refusal, nil
+		}
+
+		// Delete the relation
+		if err := linearClient.DeleteIssueRelation(relationID); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to delete issue relation: %v", err)}}}, nil
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Deleted relation %s.", relationID)}}}, nil
+	}
+}
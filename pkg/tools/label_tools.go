@@ -0,0 +1,459 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// GetLabelsTool is the tool definition for listing a team's labels
+var GetLabelsTool = mcp.NewTool("linear_get_labels",
+	mcp.WithDescription("Lists all labels of a team with their IDs. Use this to discover valid label names before assigning them to issues."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key)")),
+)
+
+// GetLabelsHandler handles the linear_get_labels tool
+func GetLabelsHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Get the labels
+		labels, err := linearClient.GetTeamLabels(teamID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get labels: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Found %d labels:\n", len(labels))
+		for _, label := range labels {
+			resultText += fmt.Sprintf("- %s (UUID: %s)\n", formatLabel(label), label.ID)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+// LabelUsageTool is the tool definition for listing labels with their usage counts
+var LabelUsageTool = mcp.NewTool("linear_label_usage",
+	mcp.WithDescription("Lists the workspace's issue labels with the number of issues using each label. Useful to identify unused or duplicate labels."),
+)
+
+// LabelUsageHandler handles the linear_label_usage tool
+func LabelUsageHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get the label usage
+		usage, err := linearClient.GetLabelUsage()
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get label usage: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Found %d labels:\n", len(usage))
+		for _, entry := range usage {
+			issueStr := "issues"
+			if entry.IssueCount == 1 {
+				issueStr = "issue"
+			}
+			resultText += fmt.Sprintf("- %s (UUID: %s): %d %s\n", entry.Name, entry.ID, entry.IssueCount, issueStr)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+// SetIssueLabelsTool is the tool definition for replacing an issue's label set
+var SetIssueLabelsTool = mcp.NewTool("linear_set_issue_labels",
+	mcp.WithDescription("Replace an issue's labels with the given set. The team is derived from the issue; labels that don't exist yet can optionally be created first."),
+	mcp.WithString("issue", mcp.Required(), mcp.Description("Issue identifier (e.g., TEAM-123) or internal UUID")),
+	mcp.WithString("labels", mcp.Required(), mcp.Description("Comma-separated list of label names or UUIDs. An empty string clears all labels.")),
+	mcp.WithBoolean("createMissing", mcp.Description("Create labels that don't exist in the issue's team yet (default: false)")),
+)
+
+// SetIssueLabelsHandler handles the linear_set_issue_labels tool
+func SetIssueLabelsHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issueIdentifier, err := request.RequireString("issue")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		labelsStr, err := request.RequireString("labels")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		createMissing := request.GetBool("createMissing", false)
+
+		// Resolve the issue and its team
+		issueID, err := resolveIssueIdentifier(linearClient, issueIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+		}
+
+		issue, err := linearClient.GetIssue(ctx, issueID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issue: %v", err)}}}, nil
+		}
+		if issue.Team == nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Issue %s has no team", issue.Identifier)}}}, nil
+		}
+		teamID := issue.Team.ID
+
+		// Split comma-separated labels
+		var labelIdentifiers []string
+		for _, label := range strings.Split(labelsStr, ",") {
+			trimmedLabel := strings.TrimSpace(label)
+			if trimmedLabel != "" {
+				labelIdentifiers = append(labelIdentifiers, trimmedLabel)
+			}
+		}
+
+		labelIDs := []string{}
+		if len(labelIdentifiers) > 0 {
+			// Create labels that don't exist yet
+			if createMissing {
+				var labelNames []string
+				for _, identifier := range labelIdentifiers {
+					if !isValidUUID(identifier) {
+						labelNames = append(labelNames, identifier)
+					}
+				}
+
+				if len(labelNames) > 0 {
+					existing, err := linearClient.GetLabelsByName(teamID, labelNames)
+					if err != nil {
+						return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get labels by name: %v", err)}}}, nil
+					}
+
+					foundLabels := make(map[string]bool)
+					for _, label := range existing {
+						foundLabels[label.Name] = true
+					}
+
+					for _, name := range labelNames {
+						if foundLabels[name] {
+							continue
+						}
+						if _, err := linearClient.CreateLabel(linear.LabelCreateInput{TeamID: teamID, Name: name}); err != nil {
+							return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to create label '%s': %v", name, err)}}}, nil
+						}
+					}
+				}
+			}
+
+			// Resolve label identifiers to UUIDs
+			resolvedLabelIDs, err := resolveLabelIdentifiers(linearClient, teamID, labelIdentifiers)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve labels: %v", err)}}}, nil
+			}
+			labelIDs = resolvedLabelIDs
+		}
+
+		// Replace the issue's label set
+		updated, err := linearClient.SetIssueLabels(issueID, labelIDs)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to set issue labels: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Labels of issue %s set to:\n", updated.Identifier)
+		if updated.Labels == nil || len(updated.Labels.Nodes) == 0 {
+			resultText += "- None\n"
+		} else {
+			for _, label := range updated.Labels.Nodes {
+				resultText += fmt.Sprintf("- %s (UUID: %s)\n", label.Name, label.ID)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+// IssuesByLabelTool is the tool definition for listing a team's issues grouped by label
+var IssuesByLabelTool = mcp.NewTool("linear_issues_by_label",
+	mcp.WithDescription("Lists a team's labels with the number of issues carrying each label, optionally including the top issues per label. Useful for triage overviews."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key)")),
+	mcp.WithBoolean("includeIssues", mcp.Description("Include up to 5 issues per label in the output (default: false)")),
+)
+
+// IssuesByLabelHandler handles the linear_issues_by_label tool
+func IssuesByLabelHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+		includeIssues := request.GetBool("includeIssues", false)
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Get the issues grouped by label
+		labels, err := linearClient.GetTeamIssuesByLabel(teamID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issues by label: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Found %d labels:\n", len(labels))
+		for _, entry := range labels {
+			issueStr := "issues"
+			if entry.IssueCount == 1 {
+				issueStr = "issue"
+			}
+			resultText += fmt.Sprintf("- %s (UUID: %s): %d %s\n", entry.Name, entry.ID, entry.IssueCount, issueStr)
+			if includeIssues {
+				for i, issue := range entry.Issues {
+					if i >= 5 {
+						break
+					}
+					resultText += fmt.Sprintf("  - %s: %s\n", issue.Identifier, issue.Title)
+				}
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+// BulkLabelTool is the tool definition for adding labels to all issues matching a search
+var BulkLabelTool = mcp.NewTool("linear_bulk_label",
+	mcp.WithDescription("Adds labels to every issue matching a search. Use dryRun first to review the affected issues."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key); scopes the search and label name resolution")),
+	mcp.WithString("labels", mcp.Required(), mcp.Description("Labels to add (comma-separated names or UUIDs)")),
+	mcp.WithString("query", mcp.Description("Optional text to search in title and description")),
+	mcp.WithString("status", mcp.Description("Filter by status name (e.g., 'In Progress', 'Done')")),
+	mcp.WithString("assignee", mcp.Description("Filter by assignee identifier (UUID, name, or email)")),
+	mcp.WithBoolean("dryRun", mcp.Description("Only list the issues that would be labeled, without changing anything (default: false)")),
+	mcp.WithNumber("maxIssues", mcp.Description("Abort if the search matches more than this many issues (default: 25)")),
+)
+
+// BulkLabelHandler handles the linear_bulk_label tool
+func BulkLabelHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		labelsStr, err := request.RequireString("labels")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		labelIdentifiers := []string{}
+		for _, label := range strings.Split(labelsStr, ",") {
+			trimmedLabel := strings.TrimSpace(label)
+			if trimmedLabel != "" {
+				labelIdentifiers = append(labelIdentifiers, trimmedLabel)
+			}
+		}
+		if len(labelIdentifiers) == 0 {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "At least one label is required"}}}, nil
+		}
+
+		dryRun := request.GetBool("dryRun", false)
+		maxIssues := request.GetInt("maxIssues", 25)
+		if maxIssues < 1 {
+			maxIssues = 1
+		}
+
+		resolver := linearClient.NewResolver()
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(resolver, team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Resolve label identifiers to UUIDs
+		labelIDs, err := resolveLabelIdentifiers(linearClient, teamID, labelIdentifiers)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve labels: %v", err)}}}, nil
+		}
+
+		// Build the search input; fetch one more than the cap to detect overflow
+		input := linear.SearchIssuesInput{
+			TeamID: teamID,
+			Query:  request.GetString("query", ""),
+			Status: request.GetString("status", ""),
+			Limit:  maxIssues + 1,
+		}
+
+		if assignee, err := request.RequireString("assignee"); err == nil && assignee != "" {
+			assigneeID, err := resolveUserIdentifier(resolver, assignee)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve assignee: %v", err)}}}, nil
+			}
+			input.AssigneeID = assigneeID
+		}
+
+		// Search for the issues to label
+		results, err := linearClient.SearchIssues(ctx, input)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to search issues: %v", err)}}}, nil
+		}
+		issues := results.Nodes
+
+		if len(issues) > maxIssues {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Search matches more than %d issues; narrow the search or raise maxIssues", maxIssues)}}}, nil
+		}
+
+		if len(issues) == 0 {
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "No issues match the search; nothing to label.\n"}}}, nil
+		}
+
+		// Dry run: only report what would change
+		if dryRun {
+			resultText := fmt.Sprintf("Dry run: would add %d label(s) to %d issue(s):\n", len(labelIDs), len(issues))
+			for _, issue := range issues {
+				resultText += fmt.Sprintf("- %s: %s\n", issue.Identifier, issue.Title)
+			}
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+		}
+
+		// Add the labels issue by issue
+		labeled := 0
+		var failures []string
+		for _, issue := range issues {
+			issueErr := false
+			for _, labelID := range labelIDs {
+				if err := linearClient.AddIssueLabel(issue.ID, labelID); err != nil {
+					failures = append(failures, fmt.Sprintf("%s: %v", issue.Identifier, err))
+					issueErr = true
+					break
+				}
+			}
+			if !issueErr {
+				labeled++
+			}
+		}
+
+		resultText := fmt.Sprintf("Added %d label(s) to %d issue(s):\n", len(labelIDs), labeled)
+		for _, issue := range issues {
+			resultText += fmt.Sprintf("- %s: %s\n", issue.Identifier, issue.Title)
+		}
+		if len(failures) > 0 {
+			resultText += fmt.Sprintf("\nFailed for %d issue(s):\n", len(failures))
+			for _, failure := range failures {
+				resultText += fmt.Sprintf("- %s\n", failure)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+// CreateLabelTool is the tool definition for creating labels
+var CreateLabelTool = mcp.NewTool("linear_create_label",
+	mcp.WithDescription("Creates a new issue label in a team."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key)")),
+	mcp.WithString("name", mcp.Required(), mcp.Description("Label name")),
+	mcp.WithString("color", mcp.Description("Optional label color as a '#RRGGBB' hex string")),
+)
+
+// CreateLabelHandler handles the linear_create_label tool
+func CreateLabelHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		name, err := request.RequireString("name")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Validate the color before sending it to the API
+		color := request.GetString("color", "")
+		if color != "" {
+			if err := validateHexColor(color); err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid color: %v", err)}}}, nil
+			}
+		}
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Return the existing label instead of failing when one with that name
+		// already exists, so repeated automation runs stay idempotent
+		existing, err := linearClient.GetLabelsByName(teamID, []string{name})
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to check existing labels: %v", err)}}}, nil
+		}
+		for _, label := range existing {
+			if label.Name == name {
+				resultText := fmt.Sprintf("Label %s already exists (UUID: %s)", label.Name, label.ID)
+				return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+			}
+		}
+
+		// Create the label
+		label, err := linearClient.CreateLabel(linear.LabelCreateInput{TeamID: teamID, Name: name, Color: color})
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to create label: %v", err)}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Created label %s (UUID: %s)", label.Name, label.ID)
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+// DeleteLabelTool is the tool definition for deleting labels
+var DeleteLabelTool = mcp.NewTool("linear_delete_label",
+	mcp.WithDescription("Deletes an issue label. Requires confirm=true."),
+	mcp.WithString("label", mcp.Required(), mcp.Description("UUID of the label to delete")),
+	mcp.WithBoolean("confirm", mcp.Required(), mcp.Description("Must be true to actually delete the label. Guards against accidental deletes.")),
+)
+
+// DeleteLabelHandler handles the linear_delete_label tool
+func DeleteLabelHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		labelID, err := request.RequireString("label")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		if !isValidUUID(labelID) {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid label UUID '%s'", labelID)}}}, nil
+		}
+
+		// Require explicit confirmation before doing anything destructive
+		if refusal := requireConfirmation(request, fmt.Sprintf("delete label '%s'", labelID)); refusal != nil {
+			return refusal, nil
+		}
+
+		// Delete the label
+		if err := linearClient.DeleteLabel(labelID); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to delete label: %v", err)}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Deleted label %s.", labelID)
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,54 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ListCyclesTool is the tool definition for listing a team's cycles
+var ListCyclesTool = mcp.NewTool("linear_list_cycles",
+	mcp.WithDescription("Lists the cycles of a team with their number, name and date range. Use this to discover valid values for the 'cycle' parameter of linear_update_issue."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key)")),
+)
+
+// ListCyclesHandler handles the linear_list_cycles tool
+func ListCyclesHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Get the cycles
+		cycles, err := linearClient.GetTeamCycles(teamID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get cycles: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Found %d cycles:\n", len(cycles))
+		for _, cycle := range cycles {
+			name := cycle.Name
+			if name == "" {
+				name = "(unnamed)"
+			}
+			dates := ""
+			if cycle.StartsAt != nil && cycle.EndsAt != nil {
+				dates = fmt.Sprintf(", %s to %s", cycle.StartsAt.Format("2006-01-02"), cycle.EndsAt.Format("2006-01-02"))
+			}
+			resultText += fmt.Sprintf("- Cycle %d: %s (UUID: %s%s)\n", cycle.Number, name, cycle.ID, dates)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
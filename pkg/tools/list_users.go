@@ -0,0 +1,61 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ListUsersTool is the tool definition for listing the organization's users
+var ListUsersTool = mcp.NewTool("linear_list_users",
+	mcp.WithDescription("Lists the users of the Linear organization."),
+	mcp.WithString("query", mcp.Description("Optional filter; returns users whose name or email contains this string (case-insensitive)")),
+)
+
+// ListUsersHandler handles the linear_list_users tool
+func ListUsersHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		query := request.GetString("query", "")
+
+		// Get the organization to access all users
+		org, err := linearClient.NewResolver().Organization()
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get organization: %v", err)}}}, nil
+		}
+
+		// Filter by name or email substring, case-insensitively
+		users := org.Users
+		if query != "" {
+			queryLower := strings.ToLower(query)
+			filtered := make([]linear.User, 0, len(users))
+			for _, user := range users {
+				if strings.Contains(strings.ToLower(user.Name), queryLower) || strings.Contains(strings.ToLower(user.Email), queryLower) {
+					filtered = append(filtered, user)
+				}
+			}
+			users = filtered
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Found %d users:\n", len(users))
+		for _, user := range users {
+			resultText += fmt.Sprintf("- %s (%s, UUID: %s)\n", user.Name, user.Email, user.ID)
+			details := []string{}
+			if user.Admin {
+				details = append(details, "admin")
+			}
+			if !user.Active {
+				details = append(details, "inactive")
+			}
+			if len(details) > 0 {
+				resultText += fmt.Sprintf("  %s\n", strings.Join(details, ", "))
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
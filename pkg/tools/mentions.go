@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// MentionsTool is the tool definition for finding mentions of a user
+var MentionsTool = mcp.NewTool("linear_mentions",
+	mcp.WithDescription("Finds open issues and comments that @-mention a given user."),
+	mcp.WithString("user", mcp.Required(), mcp.Description("User identifier (UUID, name, or email)")),
+	mcp.WithNumber("limit", mcp.Description("Maximum number of results per section (default: 25)")),
+)
+
+// MentionsHandler handles the linear_mentions tool
+func MentionsHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		userIdentifier, err := request.RequireString("user")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		limit := request.GetInt("limit", 25)
+
+		// Resolve the user identifier to a user ID
+		resolver := linearClient.NewResolver()
+		userID, err := resolveUserIdentifier(resolver, userIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve user: %v", err)}}}, nil
+		}
+
+		// Look up the user to get the handle the mention syntax uses
+		org, err := resolver.Organization()
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get organization: %v", err)}}}, nil
+		}
+
+		var user *linear.User
+		for i := range org.Users {
+			if org.Users[i].ID == userID {
+				user = &org.Users[i]
+				break
+			}
+		}
+		if user == nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("User %s not found in organization", userID)}}}, nil
+		}
+
+		handle := user.DisplayName
+		if handle == "" {
+			handle = user.Name
+		}
+		mention := "@" + handle
+
+		// Search issue titles and descriptions for the mention
+		issuesResult, err := linearClient.SearchIssues(ctx, linear.SearchIssuesInput{
+			Query:    mention,
+			OpenOnly: true,
+			Limit:    limit,
+		})
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to search issues: %v", err)}}}, nil
+		}
+		issues := issuesResult.Nodes
+
+		// Search comment bodies for the mention
+		comments, err := linearClient.SearchComments(mention, limit)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to search comments: %v", err)}}}, nil
+		}
+
+		// Keep only comments on open issues
+		openComments := []linear.CommentMatch{}
+		for _, comment := range comments {
+			if comment.Issue != nil && comment.Issue.State != nil {
+				if stateType := comment.Issue.State.Type; stateType == "completed" || stateType == "canceled" {
+					continue
+				}
+			}
+			openComments = append(openComments, comment)
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Mentions of %s (%s):\n", mention, user.Name)
+
+		resultText += fmt.Sprintf("\nOpen issues mentioning the user (%d):\n", len(issues))
+		for _, issue := range issues {
+			statusStr := "None"
+			if issue.Status != "" {
+				statusStr = issue.Status
+			} else if issue.StateName != "" {
+				statusStr = issue.StateName
+			}
+
+			resultText += fmt.Sprintf("- %s: %s [%s]\n", issue.Identifier, issue.Title, statusStr)
+			resultText += fmt.Sprintf("  URL: %s\n", issue.URL)
+		}
+
+		resultText += fmt.Sprintf("\nComments on open issues mentioning the user (%d):\n", len(openComments))
+		for _, comment := range openComments {
+			issueStr := "Unknown issue"
+			if comment.Issue != nil {
+				issueStr = fmt.Sprintf("%s: %s", comment.Issue.Identifier, comment.Issue.Title)
+			}
+
+			authorStr := "Unknown"
+			if comment.User != nil {
+				authorStr = comment.User.Name
+			}
+
+			// Only the first line of the comment body, as a preview
+			preview := strings.TrimSpace(strings.SplitN(comment.Body, "\n", 2)[0])
+
+			resultText += fmt.Sprintf("- %s\n", issueStr)
+			resultText += fmt.Sprintf("  By %s on %s: %s\n", authorStr, comment.CreatedAt.Format("2006-01-02 15:04:05"), preview)
+			resultText += fmt.Sprintf("  URL: %s\n", comment.URL)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
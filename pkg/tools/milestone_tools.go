@@ -12,6 +12,7 @@ import (
 var GetMilestoneTool = mcp.NewTool("linear_get_milestone",
 	mcp.WithDescription("Get a single project milestone by its ID or name."),
 	mcp.WithString("milestone", mcp.Required(), mcp.Description("The ID or name of the project milestone to get.")),
+	mcp.WithString("format", mcp.Description("Output format: 'text' (default) or 'json'")),
 )
 
 func GetMilestoneHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -21,17 +22,72 @@ func GetMilestoneHandler(linearClient *linear.LinearClient) func(ctx context.Con
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
 		}
 
+		format := request.GetString("format", "text")
+		if format != "text" && format != "json" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Unknown format '%s': expected 'text' or 'json'", format)}}}, nil
+		}
+
 		milestone, err := linearClient.GetMilestone(milestoneIdentifier)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get milestone: %v", err)}}}, nil
 		}
 
+		if format == "json" {
+			return jsonResult(milestone), nil
+		}
+
 		resultText := FormatMilestone(*milestone)
 
 		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
 	}
 }
 
+// ListMilestonesTool is the tool definition for listing a project's milestones
+var ListMilestonesTool = mcp.NewTool("linear_list_milestones",
+	mcp.WithDescription("Lists the milestones of a project."),
+	mcp.WithString("project", mcp.Required(), mcp.Description("The project to list milestones for (ID, name or slug).")),
+)
+
+// ListMilestonesHandler handles the linear_list_milestones tool
+func ListMilestonesHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectIdentifier, err := request.RequireString("project")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve the project identifier to a project
+		project, err := linearClient.GetProject(projectIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get project: %v", err)}}}, nil
+		}
+
+		milestones, err := linearClient.GetProjectMilestones(project.ID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get milestones: %v", err)}}}, nil
+		}
+
+		if len(milestones) == 0 {
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("No milestones found for project '%s'.\n", project.Name)}}}, nil
+		}
+
+		// Format the result
+		var builder strings.Builder
+		builder.WriteString(fmt.Sprintf("Found %d milestones for project '%s':\n", len(milestones), project.Name))
+		for _, milestone := range milestones {
+			builder.WriteString(fmt.Sprintf("- %s (UUID: %s)\n", milestone.Name, milestone.ID))
+			if milestone.TargetDate != nil {
+				builder.WriteString(fmt.Sprintf("  Target Date: %s\n", *milestone.TargetDate))
+			} else {
+				builder.WriteString("  Target Date: None\n")
+			}
+			builder.WriteString(fmt.Sprintf("  Sort Order: %g\n", milestone.SortOrder))
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: builder.String()}}}, nil
+	}
+}
+
 func FormatMilestone(milestone linear.ProjectMilestone) string {
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("Milestone: %s\n", milestone.Name))
@@ -44,6 +100,10 @@ func FormatMilestone(milestone linear.ProjectMilestone) string {
 	}
 	if milestone.Project != nil {
 		builder.WriteString(fmt.Sprintf("  Project: %s (%s)\n", milestone.Project.Name, milestone.Project.ID))
+		// Milestones have no URL of their own; link into the project instead
+		if milestone.Project.URL != "" {
+			builder.WriteString(fmt.Sprintf("  URL: %s#milestone-%s\n", milestone.Project.URL, milestone.ID))
+		}
 	}
 	return builder.String()
 }
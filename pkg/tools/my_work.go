@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// MyWorkTool is the tool definition for getting the viewer's combined work view
+var MyWorkTool = mcp.NewTool("linear_my_work",
+	mcp.WithDescription("Retrieves the authenticated user's assigned and subscribed issues in one de-duplicated list, sorted by last update."),
+	mcp.WithNumber("limit", mcp.Description("Maximum number of issues to return (default: 50)")),
+)
+
+// MyWorkHandler handles the linear_my_work tool
+func MyWorkHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		limit := request.GetInt("limit", 50)
+
+		// Get the viewer's combined issues
+		issues, err := linearClient.GetMyWork(limit)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get my work: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Found %d issues:\n", len(issues))
+		for _, issue := range issues {
+			priorityStr := "None"
+			if issue.Priority > 0 {
+				priorityStr = strconv.Itoa(issue.Priority)
+			}
+
+			statusStr := "None"
+			if issue.State != nil && issue.State.Name != "" {
+				statusStr = issue.State.Name
+			}
+
+			resultText += fmt.Sprintf("- %s: %s\n", issue.Identifier, issue.Title)
+			resultText += fmt.Sprintf("  Priority: %s\n", priorityStr)
+			resultText += fmt.Sprintf("  Status: %s\n", statusStr)
+			resultText += fmt.Sprintf("  Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
+			resultText += fmt.Sprintf("  URL: %s\n", issue.URL)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+)
+
+// OnCreateRule maps a team to triage defaults applied to newly created issues.
+type OnCreateRule struct {
+	// Team matches the issue's team by UUID, key or name (case-insensitive);
+	// "*" matches any team
+	Team string `json:"team"`
+	// Assignee is a user identifier (UUID, name, or email) to assign
+	Assignee string `json:"assignee,omitempty"`
+	// Labels are label names or UUIDs to add
+	Labels []string `json:"labels,omitempty"`
+}
+
+// OnCreateRules is the rule set loaded from the --on-create config. Rules are
+// evaluated in order; the first match wins.
+type OnCreateRules struct {
+	Rules []OnCreateRule `json:"rules"`
+}
+
+// LoadOnCreateRules reads a JSON rules file for the --on-create flag.
+func LoadOnCreateRules(path string) (*OnCreateRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read on-create rules: %w", err)
+	}
+
+	var rules OnCreateRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse on-create rules: %w", err)
+	}
+
+	return &rules, nil
+}
+
+// Match returns the first rule matching any of the given team identifiers
+// (compared case-insensitively), or nil if no rule matches.
+func (r *OnCreateRules) Match(teamIdentifiers ...string) *OnCreateRule {
+	if r == nil {
+		return nil
+	}
+
+	for i := range r.Rules {
+		rule := &r.Rules[i]
+		if rule.Team == "*" {
+			return rule
+		}
+		for _, identifier := range teamIdentifiers {
+			if identifier != "" && strings.EqualFold(rule.Team, identifier) {
+				return rule
+			}
+		}
+	}
+
+	return nil
+}
+
+// onCreateRules are applied by CreateIssueHandler after an issue is created.
+// Nil (the default) disables the rule engine.
+var onCreateRules *OnCreateRules
+
+// SetOnCreateRules configures the triage rules applied to newly created issues.
+func SetOnCreateRules(rules *OnCreateRules) {
+	onCreateRules = rules
+}
+
+// applyOnCreateRule applies the matching rule's defaults to a freshly created
+// issue and returns a human-readable summary of what was applied, or an empty
+// string when no rule matched.
+func applyOnCreateRule(linearClient *linear.LinearClient, issue *linear.Issue, teamID string, teamInput string) (string, error) {
+	rule := onCreateRules.Match(teamID, teamInput)
+	if rule == nil {
+		return "", nil
+	}
+
+	applied := []string{}
+
+	if rule.Assignee != "" {
+		assigneeID, err := resolveUserIdentifier(linearClient.NewResolver(), rule.Assignee)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve assignee '%s': %v", rule.Assignee, err)
+		}
+		if _, err := linearClient.UpdateIssue(linear.UpdateIssueInput{ID: issue.ID, AssigneeID: &assigneeID}); err != nil {
+			return "", fmt.Errorf("failed to assign '%s': %v", rule.Assignee, err)
+		}
+		applied = append(applied, fmt.Sprintf("assignee %s", rule.Assignee))
+	}
+
+	if len(rule.Labels) > 0 {
+		labelIDs, err := resolveLabelIdentifiers(linearClient, teamID, rule.Labels)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve labels: %v", err)
+		}
+		for _, labelID := range labelIDs {
+			if err := linearClient.AddIssueLabel(issue.ID, labelID); err != nil {
+				return "", fmt.Errorf("failed to add label: %v", err)
+			}
+		}
+		applied = append(applied, fmt.Sprintf("label(s) %s", strings.Join(rule.Labels, ", ")))
+	}
+
+	return strings.Join(applied, ", "), nil
+}
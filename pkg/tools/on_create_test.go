@@ -0,0 +1,106 @@
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestOnCreateRulesMatch(t *testing.T) {
+	rules := &OnCreateRules{
+		Rules: []OnCreateRule{
+			{Team: "TEST", Assignee: "gero"},
+			{Team: "234c5451-a839-4c8f-98d9-da00973f1060", Labels: []string{"Bug"}},
+			{Team: "Platform Team", Assignee: "alex"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		identifiers  []string
+		wantAssignee string
+		wantNil      bool
+	}{
+		{name: "match by key", identifiers: []string{"TEST"}, wantAssignee: "gero"},
+		{name: "match is case-insensitive", identifiers: []string{"test"}, wantAssignee: "gero"},
+		{name: "match by UUID", identifiers: []string{"234c5451-a839-4c8f-98d9-da00973f1060"}},
+		{name: "match by name", identifiers: []string{"Platform Team"}, wantAssignee: "alex"},
+		{name: "first match wins", identifiers: []string{"TEST", "Platform Team"}, wantAssignee: "gero"},
+		{name: "empty identifiers are skipped", identifiers: []string{"", "TEST"}, wantAssignee: "gero"},
+		{name: "no match", identifiers: []string{"OTHER"}, wantNil: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rule := rules.Match(tc.identifiers...)
+			if tc.wantNil {
+				if rule != nil {
+					t.Errorf("expected no match, got rule for team '%s'", rule.Team)
+				}
+				return
+			}
+			if rule == nil {
+				t.Fatalf("expected a match for %v, got nil", tc.identifiers)
+			}
+			if rule.Assignee != tc.wantAssignee {
+				t.Errorf("expected assignee '%s', got '%s'", tc.wantAssignee, rule.Assignee)
+			}
+		})
+	}
+}
+
+func TestOnCreateRulesMatchWildcard(t *testing.T) {
+	rules := &OnCreateRules{
+		Rules: []OnCreateRule{
+			{Team: "TEST", Assignee: "gero"},
+			{Team: "*", Labels: []string{"needs-triage"}},
+		},
+	}
+
+	// A known team still hits its specific rule first
+	if rule := rules.Match("TEST"); rule == nil || rule.Assignee != "gero" {
+		t.Errorf("expected the TEST rule to win over the wildcard, got %+v", rule)
+	}
+
+	// Any other team falls through to the wildcard
+	rule := rules.Match("OTHER")
+	if rule == nil || len(rule.Labels) != 1 || rule.Labels[0] != "needs-triage" {
+		t.Errorf("expected the wildcard rule, got %+v", rule)
+	}
+}
+
+func TestOnCreateRulesMatchNil(t *testing.T) {
+	var rules *OnCreateRules
+	if rule := rules.Match("TEST"); rule != nil {
+		t.Errorf("expected nil rules to match nothing, got %+v", rule)
+	}
+}
+
+func TestLoadOnCreateRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	content := `{"rules": [{"team": "TEST", "assignee": "gero", "labels": ["Bug"]}]}`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := LoadOnCreateRules(path)
+	if err != nil {
+		t.Fatalf("expected rules to load, got error: %v", err)
+	}
+	if len(rules.Rules) != 1 || rules.Rules[0].Team != "TEST" || rules.Rules[0].Assignee != "gero" {
+		t.Errorf("unexpected rules: %+v", rules.Rules)
+	}
+
+	// A missing file fails
+	if _, err := LoadOnCreateRules(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected an error for a missing rules file")
+	}
+
+	// Invalid JSON fails
+	if err := os.WriteFile(path, []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadOnCreateRules(path); err == nil {
+		t.Error("expected an error for an invalid rules file")
+	}
+}
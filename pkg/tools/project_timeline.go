@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ProjectTimelineTool is the tool definition for the milestone timeline of a project
+var ProjectTimelineTool = mcp.NewTool("linear_project_timeline",
+	mcp.WithDescription("Summarizes a project's milestones as a timeline, ordered by target date, with per-milestone completion progress."),
+	mcp.WithString("project", mcp.Required(), mcp.Description("The project to summarize (ID, name or slug)")),
+)
+
+// ProjectTimelineHandler handles the linear_project_timeline tool
+func ProjectTimelineHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		projectIdentifier, err := request.RequireString("project")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve the project identifier to a project
+		project, err := linearClient.GetProject(projectIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get project: %v", err)}}}, nil
+		}
+
+		milestones, err := linearClient.GetProjectMilestones(project.ID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get milestones: %v", err)}}}, nil
+		}
+
+		if len(milestones) == 0 {
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Project '%s' has no milestones.\n", project.Name)}}}, nil
+		}
+
+		// Order the timeline by target date; milestones without one come last,
+		// keeping the board's sort order as tie-breaker
+		sort.SliceStable(milestones, func(i, j int) bool {
+			dateI, dateJ := milestones[i].TargetDate, milestones[j].TargetDate
+			if dateI != nil && dateJ != nil && *dateI != *dateJ {
+				return *dateI < *dateJ
+			}
+			if (dateI != nil) != (dateJ != nil) {
+				return dateI != nil
+			}
+			return milestones[i].SortOrder < milestones[j].SortOrder
+		})
+
+		// Format the result
+		resultText := fmt.Sprintf("Timeline for project '%s' (%d milestones):\n", project.Name, len(milestones))
+		for _, milestone := range milestones {
+			target := "no target date"
+			if milestone.TargetDate != nil {
+				target = fmt.Sprintf("target %s", *milestone.TargetDate)
+			}
+
+			total, completed, err := linearClient.GetMilestoneIssueCounts(milestone.ID)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issue counts for milestone '%s': %v", milestone.Name, err)}}}, nil
+			}
+
+			progress := "no issues"
+			if total > 0 {
+				progress = fmt.Sprintf("%d/%d issues completed (%d%%)", completed, total, completed*100/total)
+			}
+
+			resultText += fmt.Sprintf("- %s (%s): %s\n", milestone.Name, target, progress)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -12,6 +12,7 @@ import (
 var GetProjectTool = mcp.NewTool("linear_get_project",
 	mcp.WithDescription("Get a single project."),
 	mcp.WithString("project", mcp.Required(), mcp.Description("The identifier of the project, either ID, name or slug.")),
+	mcp.WithString("format", mcp.Description("Output format: 'text' (default) or 'json'")),
 )
 
 func GetProjectHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -21,11 +22,20 @@ func GetProjectHandler(linearClient *linear.LinearClient) func(ctx context.Conte
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
 		}
 
+		format := request.GetString("format", "text")
+		if format != "text" && format != "json" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Unknown format '%s': expected 'text' or 'json'", format)}}}, nil
+		}
+
 		project, err := linearClient.GetProject(projectIdentifier)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get project: %v", err)}}}, nil
 		}
 
+		if format == "json" {
+			return jsonResult(project), nil
+		}
+
 		resultText := FormatProject(*project)
 
 		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
@@ -64,14 +74,67 @@ func SearchProjectsHandler(linearClient *linear.LinearClient) func(ctx context.C
 	}
 }
 
+// ListProjectsTool is the tool definition for listing projects
+var ListProjectsTool = mcp.NewTool("linear_list_projects",
+	mcp.WithDescription("Lists projects in the workspace, optionally filtered by team or state."),
+	mcp.WithString("team", mcp.Description("Filter by team identifier (UUID, name, or key)")),
+	mcp.WithString("state", mcp.Description("Filter by project state (e.g., 'planned', 'started', 'paused', 'completed', 'canceled')")),
+	mcp.WithNumber("limit", mcp.Description("Max results to return (default: 10)")),
+	mcp.WithString("after", mcp.Description("Pagination cursor; pass the 'Next cursor' value from a previous result to fetch the next page")),
+)
+
+// ListProjectsHandler handles the linear_list_projects tool
+func ListProjectsHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		input := linear.ListProjectsInput{}
+
+		if team, err := request.RequireString("team"); err == nil && team != "" {
+			// Resolve team identifier to a team ID
+			teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+			}
+			input.TeamID = teamID
+		}
+
+		input.State = request.GetString("state", "")
+		input.Limit = request.GetInt("limit", 10)
+		input.AfterCursor = request.GetString("after", "")
+
+		results, err := linearClient.ListProjects(input)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to list projects: %v", err)}}}, nil
+		}
+
+		// Format the result
+		var builder strings.Builder
+		builder.WriteString(fmt.Sprintf("Found %d projects:\n", len(results.Nodes)))
+		for _, project := range results.Nodes {
+			builder.WriteString(FormatProject(project))
+			builder.WriteString("\n")
+		}
+
+		// Add pagination information
+		builder.WriteString("\nPagination:\n")
+		builder.WriteString(fmt.Sprintf("Has more projects: %s\n", formatBool(results.PageInfo.HasNextPage)))
+		if results.PageInfo.HasNextPage {
+			builder.WriteString(fmt.Sprintf("Next cursor: %s\n", results.PageInfo.EndCursor))
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: builder.String()}}}, nil
+	}
+}
+
 var CreateProjectTool = mcp.NewTool("linear_create_project",
 	mcp.WithDescription("Create a new project."),
 	mcp.WithString("name", mcp.Required(), mcp.Description("The name of the project.")),
-	mcp.WithString("teamIds", mcp.Required(), mcp.Description("A comma-separated list of team IDs.")),
+	mcp.WithString("teamIds", mcp.Required(), mcp.Description("A comma-separated list of team identifiers (UUID, name, or key).")),
 	mcp.WithString("description", mcp.Description("The description of the project.")),
 	mcp.WithString("leadId", mcp.Description("The ID of the project lead.")),
+	mcp.WithString("lead", mcp.Description("The project lead as user UUID, name, or email. Takes precedence over leadId.")),
 	mcp.WithString("startDate", mcp.Description("The start date of the project (YYYY-MM-DD).")),
 	mcp.WithString("targetDate", mcp.Description("The target date of the project (YYYY-MM-DD).")),
+	mcp.WithString("icon", mcp.Description("The icon of the project (an emoji or a Linear icon name).")),
 )
 
 func CreateProjectHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -87,10 +150,32 @@ func CreateProjectHandler(linearClient *linear.LinearClient) func(ctx context.Co
 		}
 		teamIDs := strings.Split(teamIDsStr, ",")
 
+		// Resolve each team identifier (UUID, name, or key) to a team ID
+		resolver := linearClient.NewResolver()
+		for i, teamIdentifier := range teamIDs {
+			teamID, err := resolveTeamIdentifier(resolver, teamIdentifier)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+			}
+			teamIDs[i] = teamID
+		}
+
 		description := request.GetString("description", "")
 		leadID := request.GetString("leadId", "")
+		if lead := request.GetString("lead", ""); lead != "" {
+			leadID, err = resolveUserIdentifier(resolver, lead)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve lead: %v", err)}}}, nil
+			}
+		}
 		startDate := request.GetString("startDate", "")
 		targetDate := request.GetString("targetDate", "")
+		icon := request.GetString("icon", "")
+		if icon != "" {
+			if err := validateIcon(icon); err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid icon: %v", err)}}}, nil
+			}
+		}
 
 		input := linear.ProjectCreateInput{
 			Name:        name,
@@ -99,6 +184,7 @@ func CreateProjectHandler(linearClient *linear.LinearClient) func(ctx context.Co
 			LeadID:      leadID,
 			StartDate:   startDate,
 			TargetDate:  targetDate,
+			Icon:        icon,
 		}
 
 		project, err := linearClient.CreateProject(input)
@@ -118,9 +204,11 @@ var UpdateProjectTool = mcp.NewTool("linear_update_project",
 	mcp.WithString("name", mcp.Description("The new name of the project.")),
 	mcp.WithString("description", mcp.Description("The new description of the project.")),
 	mcp.WithString("leadId", mcp.Description("The ID of the project lead.")),
+	mcp.WithString("lead", mcp.Description("The project lead as user UUID, name, or email. Takes precedence over leadId.")),
 	mcp.WithString("startDate", mcp.Description("The start date of the project (YYYY-MM-DD).")),
 	mcp.WithString("targetDate", mcp.Description("The target date of the project (YYYY-MM-DD).")),
 	mcp.WithString("teamIds", mcp.Description("A comma-separated list of team IDs.")),
+	mcp.WithString("icon", mcp.Description("The new icon of the project (an emoji or a Linear icon name).")),
 )
 
 func UpdateProjectHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -130,6 +218,13 @@ func UpdateProjectHandler(linearClient *linear.LinearClient) func(ctx context.Co
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
 		}
 
+		icon := request.GetString("icon", "")
+		if icon != "" {
+			if err := validateIcon(icon); err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid icon: %v", err)}}}, nil
+			}
+		}
+
 		// Get the project first to get its ID
 		proj, err := linearClient.GetProject(projectIdentifier)
 		if err != nil {
@@ -139,6 +234,12 @@ func UpdateProjectHandler(linearClient *linear.LinearClient) func(ctx context.Co
 		name := request.GetString("name", "")
 		description := request.GetString("description", "")
 		leadID := request.GetString("leadId", "")
+		if lead := request.GetString("lead", ""); lead != "" {
+			leadID, err = resolveUserIdentifier(linearClient.NewResolver(), lead)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve lead: %v", err)}}}, nil
+			}
+		}
 		startDate := request.GetString("startDate", "")
 		targetDate := request.GetString("targetDate", "")
 		teamIDsStr := request.GetString("teamIds", "")
@@ -154,6 +255,7 @@ func UpdateProjectHandler(linearClient *linear.LinearClient) func(ctx context.Co
 			StartDate:   startDate,
 			TargetDate:  targetDate,
 			TeamIDs:     teamIDs,
+			Icon:        icon,
 		}
 
 		project, err := linearClient.UpdateProject(proj.ID, input)
@@ -167,6 +269,88 @@ func UpdateProjectHandler(linearClient *linear.LinearClient) func(ctx context.Co
 	}
 }
 
+var AddProjectMemberTool = mcp.NewTool("linear_add_project_member",
+	mcp.WithDescription("Add a user to a project's members."),
+	mcp.WithString("project", mcp.Required(), mcp.Description("The identifier of the project, either ID, name or slug.")),
+	mcp.WithString("user", mcp.Required(), mcp.Description("The user to add (UUID, name, or email).")),
+)
+
+func AddProjectMemberHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectIdentifier, err := request.RequireString("project")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		user, err := request.RequireString("user")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		userID, err := resolveUserIdentifier(linearClient.NewResolver(), user)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve user: %v", err)}}}, nil
+		}
+
+		project, err := linearClient.AddProjectMember(projectIdentifier, userID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to add project member: %v", err)}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Added user to project %s. Members are now:\n", project.Name)
+		if project.Members == nil || len(project.Members.Nodes) == 0 {
+			resultText += "- None\n"
+		} else {
+			for _, member := range project.Members.Nodes {
+				resultText += fmt.Sprintf("- %s\n", member.Name)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
+var RemoveProjectMemberTool = mcp.NewTool("linear_remove_project_member",
+	mcp.WithDescription("Remove a user from a project's members."),
+	mcp.WithString("project", mcp.Required(), mcp.Description("The identifier of the project, either ID, name or slug.")),
+	mcp.WithString("user", mcp.Required(), mcp.Description("The user to remove (UUID, name, or email).")),
+)
+
+func RemoveProjectMemberHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		projectIdentifier, err := request.RequireString("project")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		user, err := request.RequireString("user")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		userID, err := resolveUserIdentifier(linearClient.NewResolver(), user)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve user: %v", err)}}}, nil
+		}
+
+		project, err := linearClient.RemoveProjectMember(projectIdentifier, userID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to remove project member: %v", err)}}}, nil
+		}
+
+		resultText := fmt.Sprintf("Removed user from project %s. Members are now:\n", project.Name)
+		if project.Members == nil || len(project.Members.Nodes) == 0 {
+			resultText += "- None\n"
+		} else {
+			for _, member := range project.Members.Nodes {
+				resultText += fmt.Sprintf("- %s\n", member.Name)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
+
 func FormatProject(project linear.Project) string {
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("Project: %s\n", project.Name))
@@ -181,6 +365,22 @@ func FormatProject(project linear.Project) string {
 	} else {
 		builder.WriteString(fmt.Sprintf("  Lead: %s\n", project.Lead.Name))
 	}
+	if project.Members != nil && len(project.Members.Nodes) > 0 {
+		builder.WriteString("  Members:\n")
+		for _, m := range project.Members.Nodes {
+			builder.WriteString(fmt.Sprintf("    - %s\n", m.Name))
+		}
+	} else {
+		builder.WriteString("  Members: None\n")
+	}
+	if project.Teams != nil && len(project.Teams.Nodes) > 0 {
+		builder.WriteString("  Teams:\n")
+		for _, t := range project.Teams.Nodes {
+			builder.WriteString(fmt.Sprintf("    - %s (%s)\n", t.Name, t.Key))
+		}
+	} else {
+		builder.WriteString("  Teams: None\n")
+	}
 	if project.StartDate == nil {
 		builder.WriteString("  Start Date: None\n")
 	} else {
@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"fmt"
+	"time"
+)
+
+// relativeTime renders how long ago t was relative to now, e.g. "3 days ago".
+// Timestamps less than a minute away (in either direction) render as
+// "just now"; future timestamps render as "in 2 hours".
+func relativeTime(t time.Time, now time.Time) string {
+	duration := now.Sub(t)
+	future := duration < 0
+	if future {
+		duration = -duration
+	}
+
+	if duration < time.Minute {
+		return "just now"
+	}
+
+	humanized := humanizeDuration(duration)
+	if future {
+		return fmt.Sprintf("in %s", humanized)
+	}
+	return fmt.Sprintf("%s ago", humanized)
+}
+
+// humanizeDuration renders a duration in its largest sensible unit, e.g.
+// "3 days". Months and years are approximated as 30 and 365 days.
+func humanizeDuration(d time.Duration) string {
+	day := 24 * time.Hour
+
+	var count int
+	var unit string
+	switch {
+	case d < time.Hour:
+		count, unit = int(d.Minutes()), "minute"
+	case d < day:
+		count, unit = int(d.Hours()), "hour"
+	case d < 7*day:
+		count, unit = int(d/day), "day"
+	case d < 30*day:
+		count, unit = int(d/(7*day)), "week"
+	case d < 365*day:
+		count, unit = int(d/(30*day)), "month"
+	default:
+		count, unit = int(d/(365*day)), "year"
+	}
+
+	if count != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s", count, unit)
+}
+
+// formatTimestamp renders t in the output's absolute timestamp format,
+// appending the relative phrasing when requested, e.g.
+// "2024-01-01 15:04:05 (3 days ago)".
+func formatTimestamp(t time.Time, relative bool) string {
+	formatted := t.Format("2006-01-02 15:04:05")
+	if relative {
+		formatted += fmt.Sprintf(" (%s)", relativeTime(t, time.Now()))
+	}
+	return formatted
+}
@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		expected string
+	}{
+		{name: "seconds ago", t: now.Add(-30 * time.Second), expected: "just now"},
+		{name: "one minute", t: now.Add(-1 * time.Minute), expected: "1 minute ago"},
+		{name: "minutes", t: now.Add(-45 * time.Minute), expected: "45 minutes ago"},
+		{name: "one hour", t: now.Add(-1 * time.Hour), expected: "1 hour ago"},
+		{name: "hours", t: now.Add(-23 * time.Hour), expected: "23 hours ago"},
+		{name: "one day", t: now.Add(-24 * time.Hour), expected: "1 day ago"},
+		{name: "days", t: now.Add(-3 * 24 * time.Hour), expected: "3 days ago"},
+		{name: "one week", t: now.Add(-7 * 24 * time.Hour), expected: "1 week ago"},
+		{name: "weeks", t: now.Add(-20 * 24 * time.Hour), expected: "2 weeks ago"},
+		{name: "one month", t: now.Add(-35 * 24 * time.Hour), expected: "1 month ago"},
+		{name: "months", t: now.Add(-200 * 24 * time.Hour), expected: "6 months ago"},
+		{name: "one year", t: now.Add(-400 * 24 * time.Hour), expected: "1 year ago"},
+		{name: "years", t: now.Add(-3 * 365 * 24 * time.Hour), expected: "3 years ago"},
+		{name: "future just now", t: now.Add(30 * time.Second), expected: "just now"},
+		{name: "future hours", t: now.Add(2 * time.Hour), expected: "in 2 hours"},
+		{name: "future days", t: now.Add(5 * 24 * time.Hour), expected: "in 5 days"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := relativeTime(tc.t, now); got != tc.expected {
+				t.Errorf("expected '%s', got '%s'", tc.expected, got)
+			}
+		})
+	}
+}
@@ -1,12 +1,48 @@
 package tools
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
 )
 
+// formatLabel renders a label name together with its hex color when known,
+// e.g. "bug (#ff0000)". Some clients colorize their output based on it.
+func formatLabel(label linear.Label) string {
+	if label.Color == "" {
+		return label.Name
+	}
+	return fmt.Sprintf("%s (%s)", label.Name, label.Color)
+}
+
+// jsonResult marshals the given model as indented JSON and wraps it in a tool
+// result. Handlers use it when the caller asked for format=json instead of the
+// text rendering.
+func jsonResult(v interface{}) *mcp.CallToolResult {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to render JSON: %v", err)}}}
+	}
+	return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: string(data)}}}
+}
+
+// textAndStructuredResult wraps the human-readable text and an indented JSON
+// rendering of v as two content blocks in one result. Clients that understand
+// structured content can pick the JSON block, all others fall back to the text.
+func textAndStructuredResult(text string, v interface{}) *mcp.CallToolResult {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to render JSON: %v", err)}}}
+	}
+	return &mcp.CallToolResult{Content: []mcp.Content{
+		mcp.TextContent{Type: "text", Text: text},
+		mcp.TextContent{Type: "text", Text: string(data)},
+	}}
+}
+
 // Full Entity Rendering Functions
 
 // formatIssue returns a consistently formatted full representation of an issue
@@ -38,7 +74,7 @@ func formatIssue(issue *linear.Issue) string {
 	if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
 		labelNames := make([]string, 0, len(issue.Labels.Nodes))
 		for _, label := range issue.Labels.Nodes {
-			labelNames = append(labelNames, label.Name)
+			labelNames = append(labelNames, formatLabel(label))
 		}
 		result.WriteString(fmt.Sprintf("Labels: %s\n", strings.Join(labelNames, ", ")))
 	} else {
@@ -100,7 +136,11 @@ func formatComment(comment *linear.Comment) string {
 	result.WriteString(fmt.Sprintf("Body: %s\n", comment.Body))
 	
 	if !comment.CreatedAt.IsZero() {
-		result.WriteString(fmt.Sprintf("Created: %s\n", comment.CreatedAt.Format("2006-01-02 15:04:05")))
+		created := fmt.Sprintf("Created: %s", comment.CreatedAt.Format("2006-01-02 15:04:05"))
+		if comment.EditedAt != nil {
+			created += fmt.Sprintf(" (edited %s)", comment.EditedAt.Format("2006-01-02 15:04:05"))
+		}
+		result.WriteString(created + "\n")
 	}
 	
 	return result.String()
@@ -108,11 +148,44 @@ func formatComment(comment *linear.Comment) string {
 
 // Entity Identifier Rendering Functions
 
-// formatIssueIdentifier returns a consistently formatted identifier for an issue
+// Identifier verbosity levels for issue identifiers in tool output.
+const (
+	// IdentifierVerbosityShort renders just the human identifier, e.g. "TEAM-123"
+	IdentifierVerbosityShort = "short"
+	// IdentifierVerbosityNormal renders the identifier with its UUID (the default)
+	IdentifierVerbosityNormal = "normal"
+	// IdentifierVerbosityRich additionally renders the title inline
+	IdentifierVerbosityRich = "rich"
+)
+
+// identifierVerbosity controls how formatIssueIdentifier renders issues.
+var identifierVerbosity = IdentifierVerbosityNormal
+
+// SetIdentifierVerbosity configures the verbosity the rendering functions use
+// for issue identifiers.
+func SetIdentifierVerbosity(level string) error {
+	switch level {
+	case IdentifierVerbosityShort, IdentifierVerbosityNormal, IdentifierVerbosityRich:
+		identifierVerbosity = level
+		return nil
+	}
+	return fmt.Errorf("unknown identifier verbosity '%s': expected 'short', 'normal' or 'rich'", level)
+}
+
+// formatIssueIdentifier returns a consistently formatted identifier for an
+// issue, honoring the configured identifier verbosity
 func formatIssueIdentifier(issue *linear.Issue) string {
 	if issue == nil {
 		return "Issue: Unknown"
 	}
+	switch identifierVerbosity {
+	case IdentifierVerbosityShort:
+		return issue.Identifier
+	case IdentifierVerbosityRich:
+		if issue.Title != "" {
+			return fmt.Sprintf("Issue: %s: %s (UUID: %s)", issue.Identifier, issue.Title, issue.ID)
+		}
+	}
 	return fmt.Sprintf("Issue: %s (UUID: %s)", issue.Identifier, issue.ID)
 }
 
@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+)
+
+func TestFormatIssueIdentifierVerbosity(t *testing.T) {
+	issue := &linear.Issue{
+		ID:         "1c2de93f-4321-4015-bfde-ee893ef7976f",
+		Identifier: "TEST-10",
+		Title:      "Updated Test Issue",
+	}
+	untitled := &linear.Issue{
+		ID:         "1c2de93f-4321-4015-bfde-ee893ef7976f",
+		Identifier: "TEST-10",
+	}
+
+	tests := []struct {
+		level string
+		issue *linear.Issue
+		want  string
+	}{
+		{level: IdentifierVerbosityShort, issue: issue, want: "TEST-10"},
+		{level: IdentifierVerbosityNormal, issue: issue, want: "Issue: TEST-10 (UUID: 1c2de93f-4321-4015-bfde-ee893ef7976f)"},
+		{level: IdentifierVerbosityRich, issue: issue, want: "Issue: TEST-10: Updated Test Issue (UUID: 1c2de93f-4321-4015-bfde-ee893ef7976f)"},
+		// Rich falls back to normal when there is no title to render
+		{level: IdentifierVerbosityRich, issue: untitled, want: "Issue: TEST-10 (UUID: 1c2de93f-4321-4015-bfde-ee893ef7976f)"},
+		{level: IdentifierVerbosityShort, issue: nil, want: "Issue: Unknown"},
+	}
+
+	defer SetIdentifierVerbosity(IdentifierVerbosityNormal)
+	for _, tt := range tests {
+		if err := SetIdentifierVerbosity(tt.level); err != nil {
+			t.Fatalf("SetIdentifierVerbosity(%q) unexpected error: %v", tt.level, err)
+		}
+		if got := formatIssueIdentifier(tt.issue); got != tt.want {
+			t.Errorf("formatIssueIdentifier at %q = %q, want %q", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestSetIdentifierVerbosityInvalid(t *testing.T) {
+	if err := SetIdentifierVerbosity("verbose"); err == nil {
+		t.Error("SetIdentifierVerbosity(\"verbose\") expected error, got nil")
+	}
+	if identifierVerbosity != IdentifierVerbosityNormal {
+		t.Errorf("identifier verbosity changed by invalid level: %q", identifierVerbosity)
+	}
+}
@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ResolveCommentTool is the tool definition for resolving or unresolving a comment thread
+var ResolveCommentTool = mcp.NewTool("linear_resolve_comment",
+	mcp.WithDescription("Marks a comment thread as resolved or unresolved."),
+	mcp.WithString("comment", mcp.Required(), mcp.Description("UUID of the comment to resolve")),
+	mcp.WithBoolean("resolved", mcp.Description("Whether the comment should be resolved (default: true; false unresolves it)")),
+)
+
+// ResolveCommentHandler handles the linear_resolve_comment tool
+func ResolveCommentHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		commentID, err := request.RequireString("comment")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		if !isValidUUID(commentID) {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid comment ID '%s': expected a UUID", commentID)}}}, nil
+		}
+
+		resolved := request.GetBool("resolved", true)
+
+		if resolved {
+			if err := linearClient.ResolveComment(commentID); err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve comment: %v", err)}}}, nil
+			}
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Resolved comment %s.", commentID)}}}, nil
+		}
+
+		if err := linearClient.UnresolveComment(commentID); err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to unresolve comment: %v", err)}}}, nil
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Unresolved comment %s.", commentID)}}}, nil
+	}
+}
@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ResolveIssuesTool is the tool definition for resolving issue identifiers to UUIDs
+var ResolveIssuesTool = mcp.NewTool("linear_resolve_issues",
+	mcp.WithDescription("Resolves a batch of issue identifiers (e.g., 'TEAM-123') to their UUIDs. Saves repeated get_issue calls when only the ids are needed."),
+	mcp.WithString("issues", mcp.Required(), mcp.Description("Comma-separated issue identifiers (UUIDs or 'TEAM-123' style)")),
+)
+
+// ResolveIssuesHandler handles the linear_resolve_issues tool
+func ResolveIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		issuesStr, err := request.RequireString("issues")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		identifiers := []string{}
+		for _, identifier := range strings.Split(issuesStr, ",") {
+			if trimmed := strings.TrimSpace(identifier); trimmed != "" {
+				identifiers = append(identifiers, trimmed)
+			}
+		}
+		if len(identifiers) == 0 {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "At least one issue identifier is required"}}}, nil
+		}
+
+		// Resolve each identifier; UUIDs pass through without an API call
+		resultText := fmt.Sprintf("Resolved %d identifier(s):\n", len(identifiers))
+		for _, identifier := range identifiers {
+			issueID, err := resolveIssueIdentifier(linearClient, identifier)
+			if err != nil {
+				resultText += fmt.Sprintf("- %s -> not found\n", identifier)
+				continue
+			}
+			resultText += fmt.Sprintf("- %s -> %s\n", identifier, issueID)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ResolveURLTool is the tool definition for resolving a Linear deep-link
+var ResolveURLTool = mcp.NewTool("linear_resolve_url",
+	mcp.WithDescription("Resolves a Linear URL to the entity it points to (issue or project) and renders it."),
+	mcp.WithString("url", mcp.Required(), mcp.Description("Linear URL, e.g. 'https://linear.app/acme/issue/TEAM-123/fix-login'")),
+)
+
+// ResolveURLHandler handles the linear_resolve_url tool
+func ResolveURLHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		rawURL, err := request.RequireString("url")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Parse the URL; Linear URLs look like https://linear.app/<workspace>/<type>/<identifier>/...
+		parsed, err := url.Parse(rawURL)
+		if err != nil || parsed.Host != "linear.app" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Not a Linear URL: '%s'", rawURL)}}}, nil
+		}
+
+		segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+		if len(segments) < 3 {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Cannot determine the entity behind '%s': expected a path like /<workspace>/issue/TEAM-123", rawURL)}}}, nil
+		}
+		entityType := segments[1]
+		identifier := segments[2]
+
+		switch entityType {
+		case "issue":
+			// Resolve the issue identifier (e.g. 'TEAM-123') to a UUID and fetch it
+			issueID, err := resolveIssueIdentifier(linearClient, identifier)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve issue: %v", err)}}}, nil
+			}
+
+			issue, err := linearClient.GetIssue(ctx, issueID)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issue: %v", err)}}}, nil
+			}
+
+			resultText := formatIssue(issue)
+			if issue.Assignee != nil {
+				resultText += fmt.Sprintf("Assignee: %s\n", formatUserIdentifier(issue.Assignee))
+			} else {
+				resultText += "Assignee: None\n"
+			}
+			resultText += fmt.Sprintf("%s\n", formatTeamIdentifier(issue.Team))
+			resultText += "\nUse the linear_get_issue tool for attachments, relations and comments.\n"
+
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+		case "project":
+			// Project URLs carry the slug, which GetProject resolves directly
+			project, err := linearClient.GetProject(identifier)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get project: %v", err)}}}, nil
+			}
+
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: FormatProject(*project)}}}, nil
+		default:
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Unsupported Linear URL type '%s': only issue and project URLs are supported", entityType)}}}, nil
+		}
+	}
+}
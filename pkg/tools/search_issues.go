@@ -2,9 +2,11 @@ package tools
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/geropl/linear-mcp-go/pkg/linear"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -15,13 +17,24 @@ var SearchIssuesTool = mcp.NewTool("linear_search_issues",
 	mcp.WithDescription("Searches Linear issues."),
 	mcp.WithString("query", mcp.Description("Optional text to search in title and description")),
 	mcp.WithString("team", mcp.Description("Filter by team identifier (UUID, name, or key)")),
-	mcp.WithString("status", mcp.Description("Filter by status name (e.g., 'In Progress', 'Done')")),
+	mcp.WithString("status", mcp.Description("Filter by status name(s), comma-separated to match any (e.g., 'In Progress, In Review')")),
 	mcp.WithString("assignee", mcp.Description("Filter by assignee identifier (UUID, name, or email)")),
+	mcp.WithString("project", mcp.Description("Filter by project (ID, name or slug)")),
+	mcp.WithString("milestone", mcp.Description("Filter by project milestone (ID or name)")),
 	mcp.WithString("labels", mcp.Description("Filter by label names (comma-separated)")),
+	mcp.WithString("parent", mcp.Description("Filter by parent issue (UUID or identifier like 'TEAM-123'), or 'none' for top-level issues without a parent")),
 	mcp.WithNumber("priority", mcp.Description("Filter by priority (1=urgent, 2=high, 3=normal, 4=low)")),
 	mcp.WithNumber("estimate", mcp.Description("Filter by estimate points")),
+	mcp.WithBoolean("unestimated", mcp.Description("Only return issues without an estimate (mutually exclusive with 'estimate')")),
 	mcp.WithBoolean("includeArchived", mcp.Description("Include archived issues in results (default: false)")),
 	mcp.WithNumber("limit", mcp.Description("Max results to return (default: 10)")),
+	mcp.WithString("createdAfter", mcp.Description("Only issues created at or after this date (YYYY-MM-DD or RFC3339 timestamp)")),
+	mcp.WithString("createdBefore", mcp.Description("Only issues created at or before this date (YYYY-MM-DD or RFC3339 timestamp)")),
+	mcp.WithString("updatedAfter", mcp.Description("Only issues updated at or after this date (YYYY-MM-DD or RFC3339 timestamp)")),
+	mcp.WithString("updatedBefore", mcp.Description("Only issues updated at or before this date (YYYY-MM-DD or RFC3339 timestamp)")),
+	mcp.WithString("after", mcp.Description("Pagination cursor; pass the 'Next cursor' value from a previous result to fetch the next page")),
+	mcp.WithString("format", mcp.Description("Output format: 'text' (default), 'csv' with columns identifier,title,status,priority,assignee,url, or 'json'")),
+	mcp.WithBoolean("structured", mcp.Description("Additionally return the results as a structured JSON content block alongside the text (default: false)")),
 )
 
 // SearchIssuesHandler handles the linear_search_issues tool
@@ -29,12 +42,13 @@ func SearchIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Con
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		// Build search input
 		input := linear.SearchIssuesInput{}
+		resolver := linearClient.NewResolver()
 
 		input.Query = request.GetString("query", "")
 
 		if team, err := request.RequireString("team"); err == nil && team != "" {
 			// Resolve team identifier to a team ID
-			teamID, err := resolveTeamIdentifier(linearClient, team)
+			teamID, err := resolveTeamIdentifier(resolver, team)
 			if err != nil {
 				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
 			}
@@ -45,13 +59,31 @@ func SearchIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Con
 
 		if assignee, err := request.RequireString("assignee"); err == nil && assignee != "" {
 			// Resolve assignee identifier to a user ID
-			assigneeID, err := resolveUserIdentifier(linearClient, assignee)
+			assigneeID, err := resolveUserIdentifier(resolver, assignee)
 			if err != nil {
 				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve assignee: %v", err)}}}, nil
 			}
 			input.AssigneeID = assigneeID
 		}
 
+		if project, err := request.RequireString("project"); err == nil && project != "" {
+			// Resolve the project identifier to a project ID
+			proj, err := linearClient.GetProject(project)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve project: %v", err)}}}, nil
+			}
+			input.ProjectID = proj.ID
+		}
+
+		if milestone, err := request.RequireString("milestone"); err == nil && milestone != "" {
+			// Resolve the milestone identifier to a milestone ID
+			mil, err := linearClient.GetMilestone(milestone)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve milestone: %v", err)}}}, nil
+			}
+			input.MilestoneID = mil.ID
+		}
+
 		if labelsStr, err := request.RequireString("labels"); err == nil && labelsStr != "" {
 			// Split comma-separated labels
 			labels := []string{}
@@ -64,6 +96,19 @@ func SearchIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Con
 			input.Labels = labels
 		}
 
+		if parent, err := request.RequireString("parent"); err == nil && parent != "" {
+			if parent == "none" {
+				input.Parent = "none"
+			} else {
+				// Resolve parent issue identifier to a UUID
+				parentID, err := resolveIssueIdentifier(linearClient, parent)
+				if err != nil {
+					return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve parent issue: %v", err)}}}, nil
+				}
+				input.Parent = parentID
+			}
+		}
+
 		if priority, err := request.RequireInt("priority"); err == nil {
 			input.Priority = &priority
 		}
@@ -72,14 +117,76 @@ func SearchIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Con
 			input.Estimate = &estimate
 		}
 
+		input.Unestimated = request.GetBool("unestimated", false)
+		if input.Unestimated && input.Estimate != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "The 'unestimated' and 'estimate' filters are mutually exclusive"}}}, nil
+		}
+
+		// Validate and normalize the date-range filters
+		for _, dateParam := range []struct {
+			name   string
+			target *string
+		}{
+			{"createdAfter", &input.CreatedAfter},
+			{"createdBefore", &input.CreatedBefore},
+			{"updatedAfter", &input.UpdatedAfter},
+			{"updatedBefore", &input.UpdatedBefore},
+		} {
+			value := request.GetString(dateParam.name, "")
+			if value == "" {
+				continue
+			}
+			parsed, err := parseSearchDate(value)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid %s: %v", dateParam.name, err)}}}, nil
+			}
+			*dateParam.target = parsed
+		}
+
 		input.IncludeArchived = request.GetBool("includeArchived", false)
 		input.Limit = request.GetInt("limit", 10)
+		input.AfterCursor = request.GetString("after", "")
+
+		format := request.GetString("format", "text")
+		if format != "text" && format != "csv" && format != "json" {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Unknown format '%s': expected 'text', 'csv' or 'json'", format)}}}, nil
+		}
 
 		// Search for issues
-		issues, err := linearClient.SearchIssues(input)
+		results, err := linearClient.SearchIssues(ctx, input)
 		if err != nil {
 			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to search issues: %v", err)}}}, nil
 		}
+		issues := results.Nodes
+
+		if format == "json" {
+			return jsonResult(results), nil
+		}
+
+		// Render as CSV if requested
+		if format == "csv" {
+			var sb strings.Builder
+			writer := csv.NewWriter(&sb)
+			writer.Write([]string{"identifier", "title", "status", "priority", "assignee", "url"})
+			for _, issue := range issues {
+				statusStr := issue.Status
+				if statusStr == "" {
+					statusStr = issue.StateName
+				}
+
+				priorityStr := ""
+				if issue.Priority > 0 {
+					priorityStr = strconv.Itoa(issue.Priority)
+				}
+
+				writer.Write([]string{issue.Identifier, issue.Title, statusStr, priorityStr, issue.AssigneeName, issue.URL})
+			}
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to render CSV: %v", err)}}}, nil
+			}
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: sb.String()}}}, nil
+		}
 
 		// Format the result
 		resultText := fmt.Sprintf("Found %d issues:\n", len(issues))
@@ -119,6 +226,32 @@ func SearchIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Con
 			resultText += fmt.Sprintf("  URL: %s\n", issue.URL)
 		}
 
+		// Add pagination information
+		resultText += "\nPagination:\n"
+		resultText += fmt.Sprintf("Has more issues: %s\n", formatBool(results.PageInfo.HasNextPage))
+
+		if results.PageInfo.HasNextPage {
+			resultText += fmt.Sprintf("Next cursor: %s\n", results.PageInfo.EndCursor)
+		}
+
+		if request.GetBool("structured", false) {
+			return textAndStructuredResult(resultText, results), nil
+		}
+
 		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
 	}
 }
+
+// parseSearchDate validates a date-range filter value, accepting YYYY-MM-DD or
+// an RFC3339 timestamp, and normalizes it to RFC3339.
+func parseSearchDate(value string) (string, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Format(time.RFC3339), nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return "", fmt.Errorf("invalid date '%s': expected YYYY-MM-DD or RFC3339 timestamp", value)
+	}
+	return t.Format(time.RFC3339), nil
+}
@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// StaleIssuesTool is the tool definition for listing stale issues
+var StaleIssuesTool = mcp.NewTool("linear_stale_issues",
+	mcp.WithDescription("Lists a team's non-completed issues that have not been updated for a number of days, oldest first. Useful for hygiene reports."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key)")),
+	mcp.WithNumber("staleDays", mcp.Required(), mcp.Description("Issues untouched for at least this many days are considered stale")),
+	mcp.WithString("asOf", mcp.Description("Reference date (YYYY-MM-DD) the threshold is counted back from (default: today)")),
+	mcp.WithNumber("limit", mcp.Description("Maximum number of issues to return (default: 50)")),
+)
+
+// StaleIssuesHandler handles the linear_stale_issues tool
+func StaleIssuesHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		staleDays, err := request.RequireInt("staleDays")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+		if staleDays < 1 {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid staleDays %d: expected a positive number of days", staleDays)}}}, nil
+		}
+
+		asOf := time.Now()
+		if asOfStr := request.GetString("asOf", ""); asOfStr != "" {
+			asOf, err = time.Parse("2006-01-02", asOfStr)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid asOf date '%s': expected YYYY-MM-DD", asOfStr)}}}, nil
+			}
+		}
+
+		limit := request.GetInt("limit", 50)
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Get the stale issues
+		cutoff := asOf.AddDate(0, 0, -staleDays)
+		issues, err := linearClient.GetStaleIssues(teamID, cutoff, limit)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get stale issues: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Found %d stale issues (no update since %s):\n", len(issues), cutoff.Format("2006-01-02"))
+		for _, issue := range issues {
+			resultText += fmt.Sprintf("- %s: %s (last updated %s)\n", issue.Identifier, issue.Title, issue.UpdatedAt.Format("2006-01-02"))
+			resultText += fmt.Sprintf("  URL: %s\n", issue.URL)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
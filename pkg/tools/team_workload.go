@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// TeamWorkloadTool is the tool definition for summarizing a team's workload per assignee
+var TeamWorkloadTool = mcp.NewTool("linear_team_workload",
+	mcp.WithDescription("Lists the assignees of a team with their count of non-completed issues, sorted by workload. Useful for balancing work across a team."),
+	mcp.WithString("team", mcp.Required(), mcp.Description("Team identifier (UUID, name, or key)")),
+)
+
+// TeamWorkloadHandler handles the linear_team_workload tool
+func TeamWorkloadHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		team, err := request.RequireString("team")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		// Resolve team identifier to a team ID
+		teamID, err := resolveTeamIdentifier(linearClient.NewResolver(), team)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
+		}
+
+		// Get the workload
+		workload, err := linearClient.GetTeamWorkload(teamID)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get team workload: %v", err)}}}, nil
+		}
+
+		// Format the result
+		resultText := fmt.Sprintf("Workload of %d assignee(s) (non-completed issues):\n", len(workload))
+		for _, entry := range workload {
+			issueStr := "issues"
+			if entry.IssueCount == 1 {
+				issueStr = "issue"
+			}
+			resultText += fmt.Sprintf("- %s (%s): %d %s\n", entry.Name, entry.Email, entry.IssueCount, issueStr)
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/geropl/linear-mcp-go/pkg/linear"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -19,6 +20,10 @@ var UpdateIssueTool = mcp.NewTool("linear_update_issue",
 	mcp.WithString("team", mcp.Description("New team (UUID, name, or key)")),
 	mcp.WithString("projectId", mcp.Description("New project ID")),
 	mcp.WithString("milestoneId", mcp.Description("New milestone ID")),
+	mcp.WithNumber("position", mcp.Description("New board position (sortOrder); lower values sort higher within a column")),
+	mcp.WithString("dueDate", mcp.Description("New due date (YYYY-MM-DD) or relative to today: '+3d' days, '+2w' weeks, '+5b' business days")),
+	mcp.WithNumber("estimate", mcp.Description("New estimate (in the team's estimation scale); pass -1 to clear it")),
+	mcp.WithString("cycle", mcp.Description("Cycle to move the issue into, as a cycle UUID, cycle number, 'current' or 'next' (resolved within the issue's team)")),
 )
 
 // UpdateIssueHandler handles the linear_update_issue tool
@@ -50,7 +55,7 @@ func UpdateIssueHandler(linearClient *linear.LinearClient) func(ctx context.Cont
 		team := request.GetString("team", "")
 		if team != "" {
 			// Resolve team identifier to a team ID
-			teamID, err = resolveTeamIdentifier(linearClient, team)
+			teamID, err = resolveTeamIdentifier(linearClient.NewResolver(), team)
 			if err != nil {
 				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve team: %v", err)}}}, nil
 			}
@@ -60,6 +65,46 @@ func UpdateIssueHandler(linearClient *linear.LinearClient) func(ctx context.Cont
 		projectID := request.GetString("projectId", "")
 		milestoneID := request.GetString("milestoneId", "")
 
+		var sortOrder *float64
+		if position, err := request.RequireFloat("position"); err == nil {
+			sortOrder = &position
+		}
+
+		var estimate *float64
+		if e, err := request.RequireFloat("estimate"); err == nil {
+			estimate = &e
+		}
+
+		var dueDate string
+		if dueDateStr := request.GetString("dueDate", ""); dueDateStr != "" {
+			dueDate, err = parseDueDate(dueDateStr, time.Now())
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to parse due date: %v", err)}}}, nil
+			}
+		}
+
+		// Resolve the cycle identifier (UUID, number, "current" or "next") to a cycle ID
+		var cycleID string
+		if cycle := request.GetString("cycle", ""); cycle != "" {
+			if isValidUUID(cycle) {
+				cycleID = cycle
+			} else {
+				// Cycle numbers and keywords are scoped to a team, so resolve within the issue's team
+				issue, err := linearClient.GetIssue(ctx, id)
+				if err != nil {
+					return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get issue: %v", err)}}}, nil
+				}
+				if issue.Team == nil {
+					return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Issue %s has no team", issue.Identifier)}}}, nil
+				}
+
+				cycleID, err = resolveCycleIdentifier(linearClient, issue.Team.ID, cycle)
+				if err != nil {
+					return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to resolve cycle: %v", err)}}}, nil
+				}
+			}
+		}
+
 		// Update the issue
 		input := linear.UpdateIssueInput{
 			ID:          id,
@@ -70,6 +115,10 @@ func UpdateIssueHandler(linearClient *linear.LinearClient) func(ctx context.Cont
 			TeamID:      teamID,
 			ProjectID:   projectID,
 			MilestoneID: milestoneID,
+			DueDate:     dueDate,
+			CycleID:     cycleID,
+			SortOrder:   sortOrder,
+			Estimate:    estimate,
 		}
 
 		issue, err := linearClient.UpdateIssue(input)
@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// WaitForChangeTool is the tool definition for long-polling for issue changes
+var WaitForChangeTool = mcp.NewTool("linear_wait_for_change",
+	mcp.WithDescription("Blocks until at least one issue has been updated after the given cursor, or the timeout expires. A lightweight alternative to a webhook receiver; the call occupies the connection while polling, so it is mainly useful on streaming transports."),
+	mcp.WithString("since", mcp.Required(), mcp.Description("Cursor timestamp (RFC3339, e.g. 2024-01-01T00:00:00Z); issues updated after this instant count as changes")),
+	mcp.WithNumber("timeoutSeconds", mcp.Description("Maximum time to wait for a change (default: 60, max: 300)")),
+	mcp.WithNumber("pollIntervalSeconds", mcp.Description("Interval between polls (default: 5)")),
+)
+
+// WaitForChangeHandler handles the linear_wait_for_change tool
+func WaitForChangeHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		sinceStr, err := request.RequireString("since")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		since, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Invalid since cursor '%s': expected RFC3339 timestamp", sinceStr)}}}, nil
+		}
+
+		timeoutSeconds := request.GetInt("timeoutSeconds", 60)
+		if timeoutSeconds < 1 {
+			timeoutSeconds = 1
+		}
+		if timeoutSeconds > 300 {
+			timeoutSeconds = 300
+		}
+
+		pollIntervalSeconds := request.GetInt("pollIntervalSeconds", 5)
+		if pollIntervalSeconds < 0 {
+			pollIntervalSeconds = 0
+		}
+
+		deadline := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+
+		// Poll until something changed, the timeout expires, or the caller goes away
+		for {
+			if err := ctx.Err(); err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Cancelled while waiting for changes: %v", err)}}}, nil
+			}
+
+			issues, err := linearClient.GetIssuesUpdatedSince(since, 50)
+			if err != nil {
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to poll for changes: %v", err)}}}, nil
+			}
+
+			if len(issues) > 0 {
+				// Advance the cursor to the most recent update we saw
+				nextCursor := since
+				resultText := fmt.Sprintf("Detected %d changed issue(s) since %s:\n", len(issues), since.Format(time.RFC3339))
+				for _, issue := range issues {
+					resultText += fmt.Sprintf("- %s: %s (updated %s)\n", issue.Identifier, issue.Title, issue.UpdatedAt.Format(time.RFC3339))
+					if issue.UpdatedAt.After(nextCursor) {
+						nextCursor = issue.UpdatedAt
+					}
+				}
+				resultText += fmt.Sprintf("Next cursor: %s\n", nextCursor.Format(time.RFC3339))
+				return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+			}
+
+			if !time.Now().Add(time.Duration(pollIntervalSeconds) * time.Second).Before(deadline) {
+				return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("No changes detected within %d second(s). Cursor remains %s.", timeoutSeconds, since.Format(time.RFC3339))}}}, nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Cancelled while waiting for changes: %v", ctx.Err())}}}, nil
+			case <-time.After(time.Duration(pollIntervalSeconds) * time.Second):
+			}
+		}
+	}
+}
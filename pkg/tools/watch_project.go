@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geropl/linear-mcp-go/pkg/linear"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// WatchProjectTool is the tool definition for subscribing to a project's issues
+var WatchProjectTool = mcp.NewTool("linear_watch_project",
+	mcp.WithDescription("Subscribes the viewer to every active (non-completed) issue of a project, so updates show up in the inbox. Use dryRun to preview the affected issues."),
+	mcp.WithString("project", mcp.Required(), mcp.Description("The identifier of the project, either ID, name or slug.")),
+	mcp.WithBoolean("dryRun", mcp.Description("List the issues that would be subscribed to without subscribing (default: false)")),
+	mcp.WithNumber("maxIssues", mcp.Description("Maximum number of issues to subscribe to (default: 25)")),
+)
+
+// WatchProjectHandler handles the linear_watch_project tool
+func WatchProjectHandler(linearClient *linear.LinearClient) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Extract arguments
+		projectIdentifier, err := request.RequireString("project")
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: err.Error()}}}, nil
+		}
+
+		dryRun := request.GetBool("dryRun", false)
+		maxIssues := request.GetInt("maxIssues", 25)
+		if maxIssues < 1 {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: "maxIssues must be at least 1"}}}, nil
+		}
+
+		// Resolve the project
+		project, err := linearClient.GetProject(projectIdentifier)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get project: %v", err)}}}, nil
+		}
+
+		// Fetch one more than the cap to detect overly large projects
+		issues, err := linearClient.GetProjectIssues(project.ID, maxIssues+1)
+		if err != nil {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Failed to get project issues: %v", err)}}}, nil
+		}
+
+		if len(issues) > maxIssues {
+			return &mcp.CallToolResult{IsError: true, Content: []mcp.Content{mcp.TextContent{Type: "text", Text: fmt.Sprintf("Project '%s' has more than %d active issues; raise maxIssues to subscribe anyway", project.Name, maxIssues)}}}, nil
+		}
+
+		if dryRun {
+			resultText := fmt.Sprintf("Dry run: would subscribe to %d issue(s) in project '%s':\n", len(issues), project.Name)
+			for _, issue := range issues {
+				resultText += fmt.Sprintf("- %s: %s\n", issue.Identifier, issue.Title)
+			}
+			return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+		}
+
+		// Subscribe to each issue
+		var failures []string
+		subscribed := 0
+		for _, issue := range issues {
+			if err := linearClient.SubscribeToIssue(issue.ID); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", issue.Identifier, err))
+				continue
+			}
+			subscribed++
+		}
+
+		resultText := fmt.Sprintf("Subscribed to %d issue(s) in project '%s':\n", subscribed, project.Name)
+		for _, issue := range issues {
+			resultText += fmt.Sprintf("- %s: %s\n", issue.Identifier, issue.Title)
+		}
+		if len(failures) > 0 {
+			resultText += fmt.Sprintf("\nFailed for %d issue(s):\n", len(failures))
+			for _, failure := range failures {
+				resultText += fmt.Sprintf("- %s\n", failure)
+			}
+		}
+
+		return &mcp.CallToolResult{Content: []mcp.Content{mcp.TextContent{Type: "text", Text: resultText}}}, nil
+	}
+}